@@ -0,0 +1,116 @@
+package JsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+const duplicateKeyRule = "duplicateKey"
+
+// validateDuplicateKeys reports every key, which is given more than once within the same json object.
+// The parsed json only keeps the last value of a duplicated key, so the keys have to be found by tokenizing the json itself.
+// Keys of json objects unmarshalled into a struct are also duplicates, when they set the same field.
+// Since json.Unmarshal matches keys case-insensitively, keys like amount and AMOUNT would otherwise let the last key win unnoticed.
+func (validator *Validator) validateDuplicateKeys(jsonData []byte, path []PathSegment, field *FieldCache, validation *ErrorBag) {
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	var duplicates [][]PathSegment
+
+	// The json has already been parsed successfully at this point, so tokenizing it cannot fail
+	_ = validator.findDuplicateKeys(decoder, path, field, &duplicates)
+
+	for _, duplicatePath := range duplicates {
+		validation.addFieldError(validator.buildDuplicateKeyError(validation, duplicatePath))
+	}
+}
+
+func (validator *Validator) buildDuplicateKeyError(validation *ErrorBag, path []PathSegment) FieldError {
+	return validator.localize(validation.catalogs, FieldError{
+		Path:     validation.formatPath(path),
+		Segments: path,
+		Rule:     duplicateKeyRule,
+		Message:  "Is a duplicated key",
+		Category: StructureCategory,
+	}, nil)
+}
+
+// findDuplicateKeys tokenizes the json value, following the field it is unmarshalled into. The field is nil for values outside the analysis.
+func (validator *Validator) findDuplicateKeys(decoder *json.Decoder, path []PathSegment, field *FieldCache, duplicates *[][]PathSegment) error {
+	token, err := decoder.Token()
+
+	if err != nil {
+		return err
+	}
+
+	delimiter, isDelimiter := token.(json.Delim)
+
+	if !isDelimiter {
+		return nil
+	}
+
+	if delimiter == '{' {
+		occurrences := map[string]int{}
+
+		for decoder.More() {
+			keyToken, err := decoder.Token()
+
+			if err != nil {
+				return err
+			}
+
+			key := keyToken.(string)
+			keyPath := appendSegment(path, KeySegment(key))
+			entryField, fieldKey := validator.getDuplicateKeyField(field, key)
+			occurrences[fieldKey]++
+
+			// A key given more than twice is still only reported once
+			if occurrences[fieldKey] == 2 {
+				*duplicates = append(*duplicates, keyPath)
+			}
+
+			if err := validator.findDuplicateKeys(decoder, keyPath, entryField, duplicates); err != nil {
+				return err
+			}
+		}
+	} else {
+		for index := 0; decoder.More(); index++ {
+			if err := validator.findDuplicateKeys(decoder, appendSegment(path, IndexSegment(index)), validator.getDuplicateEntryField(field), duplicates); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Consumes the closing delimiter of the object or array
+	_, err = decoder.Token()
+
+	return err
+}
+
+// getDuplicateKeyField returns the field the value of the key is unmarshalled into, and the key telling which keys are duplicates of each other.
+// Keys setting the same struct field share the json key of the field, while any other key only duplicates itself.
+func (validator *Validator) getDuplicateKeyField(field *FieldCache, key string) (*FieldCache, string) {
+	if field == nil {
+		return nil, key
+	}
+
+	if field.IsMap {
+		return field.Children.All()[0], key
+	}
+
+	if !field.IsStruct {
+		return nil, key
+	}
+
+	if structField := field.Children.getByFoldedJsonKey(key); structField != nil {
+		return structField, structField.JsonKey
+	}
+
+	return nil, key
+}
+
+func (validator *Validator) getDuplicateEntryField(field *FieldCache) *FieldCache {
+	if field == nil || !field.IsSlice {
+		return nil
+	}
+
+	return field.Children.All()[0]
+}
@@ -1,17 +1,22 @@
 package JsonValidator
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
-	"strings"
+	"sort"
 )
 
 type ErrorBag struct {
-	Errors map[string][]string
+	Errors        map[string][]string // The descriptions of the errors by path, e.g. "[required]: Is required". Kept for compatibility with FieldErrors
+	fieldErrors   []FieldError        // The errors in the order they were found
+	maxErrors     int                 // The number of errors after which any further errors are dropped. Zero means no limit
+	pathFormatter PathFormatter       // Formats the paths of errors found from their segments. Nil means DottedPath
+	catalogs      []MessageCatalog    // Replace the messages of errors found, the first catalog having a message wins. Empty keeps the messages of the rules
 }
 
-func newErrorBag() *ErrorBag {
-	return &ErrorBag{Errors: map[string][]string{}}
+func newErrorBag(maxErrors int, pathFormatter PathFormatter, catalogs []MessageCatalog) *ErrorBag {
+	return &ErrorBag{Errors: map[string][]string{}, maxErrors: maxErrors, pathFormatter: pathFormatter, catalogs: catalogs}
 }
 
 func (v *ErrorBag) Error() string {
@@ -19,9 +24,51 @@ func (v *ErrorBag) Error() string {
 		return "No validation errors"
 	}
 
-	jsonBytes, _ := json.MarshalIndent(v.Errors, "", "  ")
+	var jsonBytes bytes.Buffer
+	_ = json.Indent(&jsonBytes, v.marshalErrors(), "", "  ")
 
-	return fmt.Sprintf("Validation Errors: \n%s", string(jsonBytes))
+	return fmt.Sprintf("Validation Errors: \n%s", jsonBytes.String())
+}
+
+// MarshalJSON marshals the bag like its Errors map, but with the paths in the order of GetPaths.
+func (v *ErrorBag) MarshalJSON() ([]byte, error) {
+	var jsonBytes bytes.Buffer
+
+	jsonBytes.WriteString(`{"Errors":`)
+	jsonBytes.Write(v.marshalErrors())
+	jsonBytes.WriteByte('}')
+
+	return jsonBytes.Bytes(), nil
+}
+
+// marshalErrors marshals the Errors map as a json object, with the paths in the order of GetPaths.
+func (v *ErrorBag) marshalErrors() []byte {
+	var jsonBytes bytes.Buffer
+
+	jsonBytes.WriteByte('{')
+
+	for i, path := range v.GetPaths() {
+		if i > 0 {
+			jsonBytes.WriteByte(',')
+		}
+
+		// Marshalling strings cannot fail
+		jsonPath, _ := json.Marshal(path)
+		jsonDescriptions, _ := json.Marshal(v.Errors[path])
+
+		jsonBytes.Write(jsonPath)
+		jsonBytes.WriteByte(':')
+		jsonBytes.Write(jsonDescriptions)
+	}
+
+	jsonBytes.WriteByte('}')
+
+	return jsonBytes.Bytes()
+}
+
+// Is makes every ErrorBag match ErrValidationFailed.
+func (v *ErrorBag) Is(target error) bool {
+	return target == ErrValidationFailed
 }
 
 func (v *ErrorBag) GetErrorsForKey(key string) []string {
@@ -38,6 +85,65 @@ func (v *ErrorBag) GetErrorsForKey(key string) []string {
 	return errs
 }
 
+// GetPaths returns the paths with errors, in the order of their first error.
+// Since the fields of a struct are validated in the order they are declared, and array entries by ascending index,
+// the paths follow the order of the struct and the json. Map entries are the exception, being sorted by their key instead,
+// and errors of duplicated keys come after every other error, since they are found once every value has been validated.
+// Paths only added to the Errors map directly come last, sorted alphabetically.
+func (v *ErrorBag) GetPaths() []string {
+	if v == nil || v.Errors == nil {
+		return []string{}
+	}
+
+	paths := make([]string, 0, len(v.Errors))
+	seen := make(map[string]bool, len(v.Errors))
+
+	for _, fieldError := range v.fieldErrors {
+		if _, exists := v.Errors[fieldError.Path]; exists && !seen[fieldError.Path] {
+			seen[fieldError.Path] = true
+			paths = append(paths, fieldError.Path)
+		}
+	}
+
+	if len(paths) == len(v.Errors) {
+		return paths
+	}
+
+	var remaining []string
+
+	for path := range v.Errors {
+		if !seen[path] {
+			remaining = append(remaining, path)
+		}
+	}
+
+	sort.Strings(remaining)
+
+	return append(paths, remaining...)
+}
+
+// GetFieldErrors returns every error in the order it was found.
+func (v *ErrorBag) GetFieldErrors() []FieldError {
+	if v == nil {
+		return nil
+	}
+
+	return v.fieldErrors
+}
+
+// GetFieldErrorsForKey returns the errors of the value at the dotted path, in the order they were found.
+func (v *ErrorBag) GetFieldErrorsForKey(key string) []FieldError {
+	var fieldErrors []FieldError
+
+	for _, fieldError := range v.GetFieldErrors() {
+		if fieldError.Path == key {
+			fieldErrors = append(fieldErrors, fieldError)
+		}
+	}
+
+	return fieldErrors
+}
+
 func (v *ErrorBag) CountErrors() int {
 	if v == nil || v.Errors == nil {
 		return 0
@@ -58,18 +164,8 @@ func (v *ErrorBag) HasFailedKeyAndRule(key string, rule string) bool {
 		return false
 	}
 
-	if v.Errors == nil {
-		return false
-	}
-
-	errorList, failedKey := v.Errors[key]
-
-	if !failedKey {
-		return false
-	}
-
-	for _, errorText := range errorList {
-		if strings.Index(errorText, fmt.Sprintf("[%s]: ", rule)) == 0 {
+	for _, fieldError := range v.fieldErrors {
+		if fieldError.Path == key && fieldError.Rule == rule {
 			return true
 		}
 	}
@@ -77,18 +173,45 @@ func (v *ErrorBag) HasFailedKeyAndRule(key string, rule string) bool {
 	return false
 }
 
+// AddError adds an error with a description of the form "[rule]: message".
+// The rule and message of the FieldError are parsed from the description, and its segments from the path like the paths given to Add.
 func (v *ErrorBag) AddError(path string, description string) {
-	if v.Errors == nil {
-		v.Errors = map[string][]string{}
+	rule, message := parseDescription(description)
+
+	v.addFieldError(FieldError{
+		Path:     path,
+		Segments: parsePath(v.pathFormatter, path),
+		Rule:     rule,
+		Message:  message,
+		Category: ValueCategory,
+	})
+}
+
+// formatPath formats the path with the path formatter of the validation.
+func (v *ErrorBag) formatPath(path []PathSegment) string {
+	if v.pathFormatter == nil {
+		return formatPath(DottedPath, path)
 	}
 
-	targetBucket, ok := v.Errors[path]
+	return formatPath(v.pathFormatter, path)
+}
 
-	if !ok {
-		targetBucket = []string{}
+func (v *ErrorBag) addFieldError(fieldError FieldError) {
+	if v.IsFull() {
+		return
+	}
+
+	if v.Errors == nil {
+		v.Errors = map[string][]string{}
 	}
 
-	v.Errors[path] = append(targetBucket, description)
+	v.Errors[fieldError.Path] = append(v.Errors[fieldError.Path], fieldError.description())
+	v.fieldErrors = append(v.fieldErrors, fieldError)
+}
+
+// IsFull reports whether the maximum number of errors has been reached, so the validation can stop early.
+func (v *ErrorBag) IsFull() bool {
+	return v.maxErrors > 0 && v.CountErrors() >= v.maxErrors
 }
 
 func (v *ErrorBag) IsValid() bool {
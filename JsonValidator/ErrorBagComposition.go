@@ -0,0 +1,152 @@
+package JsonValidator
+
+// Add adds the errors to the bag, e.g. the errors of checks outside the validator.
+// An error with only a path gets its segments from the path, and an error with only segments gets its path formatted by the PathFormatter.
+// An error without a category is a ValueCategory error.
+func (v *ErrorBag) Add(fieldErrors ...FieldError) {
+	for _, fieldError := range fieldErrors {
+		if fieldError.Segments == nil {
+			fieldError.Segments = parsePath(v.pathFormatter, fieldError.Path)
+		} else if fieldError.Path == "" {
+			fieldError.Path = v.formatPath(fieldError.Segments)
+		}
+
+		if fieldError.Category == "" {
+			fieldError.Category = ValueCategory
+		}
+
+		v.addFieldError(fieldError)
+	}
+}
+
+// Merge adds every error of the other bags to the bag, in the order of the bags.
+// The errors keep their paths, so the bags should be validated with the same PathFormatter. Use WithPrefix to place the errors of a part below a path.
+// Nil bags are skipped, and merging into a nil bag does nothing.
+func (v *ErrorBag) Merge(others ...*ErrorBag) {
+	if v == nil {
+		return
+	}
+
+	for _, other := range others {
+		for _, fieldError := range other.allFieldErrors() {
+			v.addFieldError(fieldError)
+		}
+	}
+}
+
+// WithPrefix returns a new bag with the errors of the bag placed below the path, e.g. the errors of a line item validated on its own below items.3.
+// The path is given in the format of the PathFormatter of the bag, and the paths of the errors are formatted anew.
+func (v *ErrorBag) WithPrefix(path string) *ErrorBag {
+	prefixed := v.derive()
+	prefix := parsePath(prefixed.pathFormatter, path)
+
+	for _, fieldError := range v.allFieldErrors() {
+		fieldError.Segments = append(append([]PathSegment(nil), prefix...), fieldError.Segments...)
+		fieldError.Path = prefixed.formatPath(fieldError.Segments)
+		prefixed.addFieldError(fieldError)
+	}
+
+	return prefixed
+}
+
+// Filter returns a new bag with the errors the predicate is true for, e.g. only the errors of a category.
+func (v *ErrorBag) Filter(predicate func(fieldError FieldError) bool) *ErrorBag {
+	filtered := v.derive()
+
+	for _, fieldError := range v.allFieldErrors() {
+		if predicate(fieldError) {
+			filtered.addFieldError(fieldError)
+		}
+	}
+
+	return filtered
+}
+
+// Without returns a new bag without the errors of the value at the path, or of any value nested within it.
+// The path is given in the format of the PathFormatter of the bag.
+func (v *ErrorBag) Without(path string) *ErrorBag {
+	if v == nil {
+		return v.derive()
+	}
+
+	prefix := parsePath(v.pathFormatter, path)
+
+	return v.Filter(func(fieldError FieldError) bool {
+		return !hasPathPrefix(fieldError.Segments, prefix)
+	})
+}
+
+// ForPath returns a new bag with the errors of the value at the path, or of any value nested within it.
+// The paths of the errors are relative to the path, so the value becomes the root of the new bag, just like the inverse of WithPrefix.
+// The path is given in the format of the PathFormatter of the bag.
+func (v *ErrorBag) ForPath(path string) *ErrorBag {
+	subtree := v.derive()
+	prefix := parsePath(subtree.pathFormatter, path)
+
+	for _, fieldError := range v.allFieldErrors() {
+		if !hasPathPrefix(fieldError.Segments, prefix) {
+			continue
+		}
+
+		fieldError.Segments = fieldError.Segments[len(prefix):len(fieldError.Segments):len(fieldError.Segments)]
+		fieldError.Path = subtree.formatPath(fieldError.Segments)
+		subtree.addFieldError(fieldError)
+	}
+
+	return subtree
+}
+
+// derive returns an empty bag, which formats paths like the bag, but without its limit on the number of errors.
+func (v *ErrorBag) derive() *ErrorBag {
+	if v == nil {
+		return newErrorBag(0, nil, nil)
+	}
+
+	return newErrorBag(0, v.pathFormatter, nil)
+}
+
+// allFieldErrors returns every error of the bag, including the descriptions only added to the Errors map directly.
+// Such descriptions come last, and are parsed like the descriptions given to AddError.
+func (v *ErrorBag) allFieldErrors() []FieldError {
+	if v == nil {
+		return nil
+	}
+
+	fieldErrors := append([]FieldError(nil), v.fieldErrors...)
+	counts := map[string]int{}
+
+	for _, fieldError := range v.fieldErrors {
+		counts[fieldError.Path]++
+	}
+
+	for _, path := range v.GetPaths() {
+		for _, description := range v.Errors[path][min(counts[path], len(v.Errors[path])):] {
+			rule, message := parseDescription(description)
+			fieldErrors = append(fieldErrors, FieldError{
+				Path:     path,
+				Segments: parsePath(v.pathFormatter, path),
+				Rule:     rule,
+				Message:  message,
+				Category: ValueCategory,
+			})
+		}
+	}
+
+	return fieldErrors
+}
+
+// hasPathPrefix reports whether the path is the prefix itself, or a path nested within it.
+// Segments are compared by their text, since paths parsed from text cannot always tell indices from keys.
+func hasPathPrefix(path []PathSegment, prefix []PathSegment) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+
+	for i, segment := range prefix {
+		if segment.String() != path[i].String() {
+			return false
+		}
+	}
+
+	return true
+}
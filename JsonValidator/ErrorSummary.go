@@ -0,0 +1,74 @@
+package JsonValidator
+
+import "fmt"
+
+// ErrorSummary groups the errors of the same rule at the same path, once the indexes of json arrays are replaced with wildcards.
+// E.g. a missing currency in 5000 entries of items becomes a single summary of items.*.currency with a count of 5000.
+type ErrorSummary struct {
+	Path     string        `json:"path"`              // The path with a wildcard for every array index, formatted by the PathFormatter, e.g. items.*.currency
+	Rule     string        `json:"rule"`              // The name of the failed rule, e.g. required
+	Message  string        `json:"message"`           // The message of the first error of the group
+	Category ErrorCategory `json:"category"`          // The kind of failure
+	Count    int           `json:"count"`             // The number of errors in the group
+	Indexes  [][]int       `json:"indexes,omitempty"` // The array indexes of the first errors of the group, with an index for each wildcard of the path
+	IsKey    bool          `json:"isKey,omitempty"`   // True if the errors are of the keys of map entries, see FieldError.IsKey
+}
+
+// Summarize groups the errors of the same rule at the same path, once the indexes of json arrays are replaced with wildcards.
+// The summaries are in the order of the first error of each group, and errors outside of arrays are summaries of their own.
+// At most maxIndexes indexes are reported for each summary, while the count still includes every error. Zero means no limit.
+// Errors added by path have their segments parsed from the path, where keys consisting of digits are taken to be indexes.
+func (v *ErrorBag) Summarize(maxIndexes int) []ErrorSummary {
+	var summaries []ErrorSummary
+	groups := map[string]int{}
+
+	for _, fieldError := range v.GetFieldErrors() {
+		wildcardPath, indexes := wildcardIndexes(fieldError.Segments)
+		path := fieldError.Path
+
+		if len(indexes) > 0 {
+			path = v.formatPath(wildcardPath)
+		}
+
+		key := fmt.Sprintf("%s\x00%s\x00%t", path, fieldError.Rule, fieldError.IsKey)
+		group, exists := groups[key]
+
+		if !exists {
+			group = len(summaries)
+			groups[key] = group
+			summaries = append(summaries, ErrorSummary{
+				Path:     path,
+				Rule:     fieldError.Rule,
+				Message:  fieldError.Message,
+				Category: fieldError.Category,
+				IsKey:    fieldError.IsKey,
+			})
+		}
+
+		summary := &summaries[group]
+		summary.Count++
+
+		if len(indexes) > 0 && (maxIndexes == 0 || len(summary.Indexes) < maxIndexes) {
+			summary.Indexes = append(summary.Indexes, indexes)
+		}
+	}
+
+	return summaries
+}
+
+// wildcardIndexes replaces every index of the path with a wildcard, and returns the indexes replaced.
+func wildcardIndexes(path []PathSegment) ([]PathSegment, []int) {
+	wildcardPath := make([]PathSegment, len(path))
+	var indexes []int
+
+	for i, segment := range path {
+		if segment.IsIndex() {
+			indexes = append(indexes, segment.Index)
+			segment = wildcardSegment()
+		}
+
+		wildcardPath[i] = segment
+	}
+
+	return wildcardPath, indexes
+}
@@ -0,0 +1,36 @@
+package JsonValidator
+
+import (
+	"errors"
+	"fmt"
+)
+
+// The categories of errors returned by the validator, which can be told apart with errors.Is.
+var (
+	ErrInvalidJSON       = errors.New("invalid json")              // The json cannot be parsed, see SyntaxError
+	ErrValidationFailed  = errors.New("validation failed")         // The json breaks the validation rules, see ErrorBag
+	ErrInvalidSchema     = errors.New("invalid validation schema") // The validation tags or rules of the target type are invalid
+	ErrUnsupportedTarget = errors.New("unsupported target")        // The target cannot be analyzed or unmarshalled into
+)
+
+// invalidSchemaError is panicked with when analyzing or validating a schema which cannot be used, e.g. a tag with an unknown rule.
+// The validator recovers the panic, and returns the error wrapping ErrInvalidSchema.
+func invalidSchemaError(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", ErrInvalidSchema, fmt.Sprintf(format, args...))
+}
+
+// recoverInvalidSchema sets the error from a panic of an invalid schema. Any other panic is passed on.
+func recoverInvalidSchema(err *error) {
+	recovered := recover()
+
+	if recovered == nil {
+		return
+	}
+
+	if schemaError, isError := recovered.(error); isError && errors.Is(schemaError, ErrInvalidSchema) {
+		*err = schemaError
+		return
+	}
+
+	panic(recovered)
+}
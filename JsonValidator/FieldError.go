@@ -0,0 +1,108 @@
+package JsonValidator
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ErrorCategory tells what kind of problem a FieldError is, without having to know every rule.
+type ErrorCategory string
+
+const (
+	PresenceCategory  ErrorCategory = "presence"  // A presence rule failed, e.g. a required value is missing
+	TypeCategory      ErrorCategory = "type"      // The value has the wrong json type, or cannot be unmarshalled into the target
+	ValueCategory     ErrorCategory = "value"     // The value has the right type, but breaks a rule such as min or regex
+	StructureCategory ErrorCategory = "structure" // The json object has unknown or duplicated keys
+)
+
+// FieldError is a single failed rule of a json value.
+type FieldError struct {
+	Path     string          `json:"path"`               // The json path of the value formatted by the PathFormatter, e.g. lines.1.amount
+	Segments []PathSegment   `json:"-"`                  // The json path of the value, from the root to the value
+	Rule     string          `json:"rule"`               // The name of the failed rule, e.g. required
+	Params   []string        `json:"params"`             // The params given to the rule in the validation tag
+	Message  string          `json:"message"`            // The description of the failure, e.g. Must be at least [1]
+	Value    any             `json:"value"`              // The parsed json value which was rejected. Nil when the value is missing
+	Category ErrorCategory   `json:"category"`           // The kind of failure
+	Position *SourcePosition `json:"position,omitempty"` // The position of the value within the json. Only set with WithSourcePositions
+	IsKey    bool            `json:"isKey,omitempty"`    // True if the rule failed on the key of the map entry at the path, rather than on its value
+}
+
+// description is the text of the error within ErrorBag.Errors, e.g. "[required]: Is required".
+func (fieldError *FieldError) description() string {
+	if fieldError.Rule == "" {
+		return fieldError.Message
+	}
+
+	return fmt.Sprintf("[%s]: %s", fieldError.Rule, fieldError.Message)
+}
+
+// SegmentKind tells whether a PathSegment is a key or an index. The zero value is a key.
+type SegmentKind int
+
+const (
+	KeyKind      SegmentKind = iota // The key of a json object
+	IndexKind                       // The index of a json array
+	WildcardKind                    // Every index of a json array, see ErrorBag.Summarize
+)
+
+// PathSegment is either the key of a json object, or the index of a json array.
+// A segment is a key unless its Kind says otherwise, so PathSegment{Key: "lines"} is the key "lines".
+type PathSegment struct {
+	Key   string
+	Index int // The index of an IndexKind segment
+	Kind  SegmentKind
+}
+
+// KeySegment returns the segment of the key of a json object.
+func KeySegment(key string) PathSegment {
+	return PathSegment{Key: key}
+}
+
+// IndexSegment returns the segment of the index of a json array.
+func IndexSegment(index int) PathSegment {
+	return PathSegment{Index: index, Kind: IndexKind}
+}
+
+func wildcardSegment() PathSegment {
+	return PathSegment{Key: "*", Kind: WildcardKind}
+}
+
+// IsWildcard reports whether the segment stands in for every index of a json array. Its key is then "*".
+func (segment PathSegment) IsWildcard() bool {
+	return segment.Kind == WildcardKind
+}
+
+func (segment PathSegment) IsIndex() bool {
+	return segment.Kind == IndexKind
+}
+
+func (segment PathSegment) String() string {
+	if segment.IsIndex() {
+		return strconv.Itoa(segment.Index)
+	}
+
+	return segment.Key
+}
+
+// appendSegment returns a new path with the segment appended, which never shares its backing array with the given path.
+func appendSegment(path []PathSegment, segment PathSegment) []PathSegment {
+	return append(path[:len(path):len(path)], segment)
+}
+
+// parseDescription splits an error text of the form "[rule]: message" into the rule and the message.
+// Texts of any other form are kept as the message, without a rule.
+func parseDescription(description string) (string, string) {
+	if !strings.HasPrefix(description, "[") {
+		return "", description
+	}
+
+	rule, message, found := strings.Cut(description[1:], "]: ")
+
+	if !found {
+		return "", description
+	}
+
+	return rule, message
+}
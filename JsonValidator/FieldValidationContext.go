@@ -1,6 +1,10 @@
 package JsonValidator
 
-import "strconv"
+import (
+	"context"
+	"math/big"
+	"strconv"
+)
 
 type FieldValidationContext struct {
 	Validation *ValidationContext
@@ -8,6 +12,11 @@ type FieldValidationContext struct {
 	RuleName   string
 }
 
+// Context returns the context given to ValidateContext, so rules can read request-scoped values or respect its deadline.
+func (context *FieldValidationContext) Context() context.Context {
+	return context.Validation.RootContext.Context
+}
+
 func (context *FieldValidationContext) GetParam(index int) string {
 	return context.Params[index]
 }
@@ -16,7 +25,7 @@ func (context *FieldValidationContext) GetIntParam(index int) int {
 	value, err := strconv.Atoi(context.Params[index])
 
 	if err != nil {
-		panic(err)
+		panic(invalidSchemaError("Invalid integer param [%s] for rule [%s]", context.Params[index], context.RuleName))
 	}
 
 	return value
@@ -26,7 +35,18 @@ func (context *FieldValidationContext) GetFloatParam(index int) float64 {
 	value, err := strconv.ParseFloat(context.Params[index], 64)
 
 	if err != nil {
-		panic(err)
+		panic(invalidSchemaError("Invalid float param [%s] for rule [%s]", context.Params[index], context.RuleName))
+	}
+
+	return value
+}
+
+// GetNumberParam returns the param as an exact number, so it can be compared to json numbers without loss of precision.
+func (context *FieldValidationContext) GetNumberParam(index int) *big.Rat {
+	value, ok := new(big.Rat).SetString(context.Params[index])
+
+	if !ok {
+		panic(invalidSchemaError("Invalid number param [%s] for rule [%s]", context.Params[index], context.RuleName))
 	}
 
 	return value
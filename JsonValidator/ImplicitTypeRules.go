@@ -0,0 +1,154 @@
+package JsonValidator
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"time"
+)
+
+var jsonUnmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+var jsonNumberType = reflect.TypeOf(json.Number(""))
+var timeType = reflect.TypeOf(time.Time{})
+
+// implicitTypeRuleGroups lists the explicit rules which already cover an implicit type rule.
+// An implicit type rule is skipped, when any rule of its group is given explicitly, so the explicit rule takes precedence.
+// The sized int and uint rules are not listed, since only a sized rule of the same kind covers the range of the type, see hasExplicitTypeRule.
+var implicitTypeRuleGroups = map[string][]string{
+	"float":   {"float", "int", "integer", "uint"},
+	"string":  {"string"},
+	"bool":    {"bool", "boolean"},
+	"array":   {"array"},
+	"object":  {"object", "objectMissingKeys"},
+	"rfc3339": {"rfc3339"},
+}
+
+// integerRules are the explicit rules, after which the range of a sized int or uint type is checked, see withImplicitTypeRules.
+var integerRules = []string{"int", "integer", "uint"}
+
+// withImplicitTypeRules merges the rules derived from the Go type of a field into its validation tag.
+// The type rules are run before the explicit rules, and pointer types are implicitly nullable, since null unmarshals into a nil pointer.
+// Fields of any other type reject null, even though json.Unmarshal leaves them unchanged, since null is no value of their type.
+func (structCache *StructCache) withImplicitTypeRules(validationTag *ValidationTag, fieldType reflect.Type, quoted bool, rulebook *Rulebook) *ValidationTag {
+	typeRules := structCache.getImplicitTypeRules(structCache.typeIndirect(fieldType), quoted)
+	implicitRules := make([]*RuleContext, 0, len(typeRules)+len(validationTag.Rules))
+	explicitRules := validationTag.Rules
+
+	for _, typeRule := range typeRules {
+		rule := rulebook.GetRule(typeRule)
+
+		if structCache.hasExplicitTypeRule(validationTag, rule) {
+			continue
+		}
+
+		// The range of the type is checked after an explicit integer rule, and only when no earlier rule failed, so a single invalid value is not reported twice
+		if index := structCache.lastExplicitIntegerRule(validationTag, rule); index >= 0 {
+			rangeRule := *rule
+			rangeRule.skipAfterFailure = true
+			explicitRules = slices.Insert(slices.Clone(explicitRules), index+1, &rangeRule)
+			continue
+		}
+
+		implicitRules = append(implicitRules, rule)
+	}
+
+	// A copy of the tag, so every flag of the tag is kept without having to be listed here
+	merged := *validationTag
+	merged.Rules = append(implicitRules, explicitRules...)
+	merged.ExplicitlyNullable = validationTag.ExplicitlyNullable || fieldType.Kind() == reflect.Pointer
+
+	return &merged
+}
+
+// hasImplicitTypeRules reports whether json.Unmarshal sets the field through a json key of its own, so type rules apply to it.
+// This includes embedded fields with a json key in the tag and embedded types which are not structs, which json treats as regular fields.
+// Embedded structs without a json key are skipped, since their fields are promoted into the parent struct and get type rules of their own.
+func (structCache *StructCache) hasImplicitTypeRules(field reflect.StructField, jsonTag *JsonTag) bool {
+	if !structCache.isUnmarshalledField(field) {
+		return false
+	}
+
+	return !field.Anonymous || jsonTag.Tagged || !structCache.typeIsStruct(structCache.typeIndirect(field.Type))
+}
+
+func (structCache *StructCache) hasExplicitTypeRule(validationTag *ValidationTag, typeRule *RuleContext) bool {
+	for _, rule := range validationTag.Rules {
+		// E.g. int:8 is covered by an explicit int:8, but not by integer or int:16, which accept values beyond the range of an int8
+		if typeRule.Name == "int" || typeRule.Name == "uint" {
+			if rule.Name == typeRule.Name && len(rule.Params) > 0 && rule.GetIntParam(0) <= typeRule.GetIntParam(0) {
+				return true
+			}
+
+			continue
+		}
+
+		if slices.Contains(implicitTypeRuleGroups[typeRule.Name], rule.Name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// lastExplicitIntegerRule returns the index of the last explicit integer rule, when the type rule is a sized int or uint rule, or -1 otherwise.
+func (structCache *StructCache) lastExplicitIntegerRule(validationTag *ValidationTag, typeRule *RuleContext) int {
+	if typeRule.Name != "int" && typeRule.Name != "uint" {
+		return -1
+	}
+
+	for i := len(validationTag.Rules) - 1; i >= 0; i-- {
+		if slices.Contains(integerRules, validationTag.Rules[i].Name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// getImplicitTypeRules returns the rules for the json values, which json.Unmarshal accepts for the type, apart from null.
+// Quoted fields use the ",string" option of the json tag, and are therefor always given as json strings.
+func (structCache *StructCache) getImplicitTypeRules(fieldType reflect.Type, quoted bool) []string {
+	switch {
+	case fieldType == timeType:
+		return []string{"rfc3339"}
+	case fieldType == jsonNumberType:
+		return []string{"float"}
+	case reflect.PointerTo(fieldType).Implements(jsonUnmarshalerType):
+		// The type decides for itself which json values it accepts
+		return nil
+	case reflect.PointerTo(fieldType).Implements(textUnmarshalerType):
+		return []string{"string"}
+	}
+
+	if quoted {
+		return []string{"string"}
+	}
+
+	switch fieldType.Kind() {
+	case reflect.Bool:
+		return []string{"bool"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return []string{fmt.Sprintf("int:%d", fieldType.Bits())}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return []string{fmt.Sprintf("uint:%d", fieldType.Bits())}
+	case reflect.Float32, reflect.Float64:
+		return []string{"float"}
+	case reflect.String:
+		return []string{"string"}
+	case reflect.Slice:
+		// Byte slices are given as base64 encoded json strings
+		if fieldType.Elem().Kind() == reflect.Uint8 && !reflect.PointerTo(fieldType.Elem()).Implements(jsonUnmarshalerType) && !reflect.PointerTo(fieldType.Elem()).Implements(textUnmarshalerType) {
+			return []string{"string"}
+		}
+
+		return []string{"array"}
+	case reflect.Array:
+		return []string{"array"}
+	case reflect.Struct, reflect.Map:
+		return []string{"object"}
+	}
+
+	// Interfaces accept any json value
+	return nil
+}
@@ -0,0 +1,84 @@
+package JsonValidator
+
+// EnglishMessages is the catalog of the "en" locale.
+// The rules describe their errors in English already, so it only holds the messages the catalog renders exactly like the rules.
+// Messages describing the rejected value, such as the actual length of lenMin or the given value of date, are left to the rules.
+var EnglishMessages = MessageCatalog{
+	"required":        "Is a required non-nullable field",
+	"present":         "Key must be present",
+	"array":           "Must be an array",
+	"object":          "Must be an object",
+	"string":          "Must be a string",
+	"float":           "Must be a float",
+	"bool":            "Must be a boolean",
+	"uuid":            "Must be a valid uuid string",
+	"zeroableUuid":    "Must be a valid uuid string and not the zero uuid",
+	"between":         "Must be a number between {0} and {1}",
+	"min":             "Must be a number greater than or equal to {0}",
+	"max":             "Must be a number less than or equal to {0}",
+	"ip":              "Must be a valid ip string",
+	"email":           "Must be a valid email string",
+	"json":            "Must be a valid json string",
+	typeRule:          "Cannot be unmarshalled",
+	typeRule + ":{0}": "Must be a value convertible to [{0}]",
+	strictRule:        "Is not a known field",
+	duplicateKeyRule:  "Is a duplicated key",
+}
+
+// DanishMessages is the catalog of the "da" locale.
+var DanishMessages = MessageCatalog{
+	"required":           "Er et påkrævet felt, som ikke må være null",
+	"present":            "Nøglen skal være til stede",
+	"len":                "Længden skal være præcis {0}",
+	"lenMin":             "Længden skal være mindst {0}",
+	"lenMax":             "Længden må højst være {0}",
+	"lenBetween":         "Længden skal være mellem {0} og {1}",
+	"missingIf":          "Må ikke være til stede, når [{fields}] har værdien [{1}]",
+	"missingUnless":      "Må ikke være til stede, medmindre [{fields}] har værdien [{1}]",
+	"missingWith":        "Må ikke være til stede, når {fields} er til stede",
+	"missingWithAny":     "Må ikke være til stede, når et af [{fields}] er til stede",
+	"missingWithAll":     "Må ikke være til stede, når alle af [{fields}] er til stede",
+	"missingWithout":     "Må ikke være til stede, når {fields} ikke er til stede",
+	"missingWithoutAny":  "Må ikke være til stede, når et af [{fields}] ikke er til stede",
+	"missingWithoutAll":  "Må ikke være til stede, når alle af [{fields}] ikke er til stede",
+	"requiredWith":       "Er påkrævet, når {fields} er til stede",
+	"requiredWithAny":    "Er påkrævet, når et af [{fields}] er til stede",
+	"requiredWithAll":    "Er påkrævet, når alle af [{fields}] er til stede",
+	"requiredWithout":    "Er påkrævet, når {fields} ikke er til stede",
+	"requiredWithoutAny": "Er påkrævet, når et af [{fields}] ikke er til stede",
+	"requiredWithoutAll": "Er påkrævet, når alle af [{fields}] ikke er til stede",
+	"requireOneInGroup":  "Præcis ét felt i gruppen [{0}] skal være til stede og må ikke være null",
+	"date":               "Skal være en dato i formatet YYYY-MM-DD",
+	"rfc3339":            "Skal være et tidspunkt i RFC 3339-format",
+	"array":              "Skal være et array",
+	"object":             "Skal være et objekt",
+	"objectMissingKeys":  "Skal være et objekt uden nogen af følgende nøgler: [{params}]",
+	"string":             "Skal være en tekststreng",
+	"int":                "Skal være et heltal",
+	"int:{0}":            "Skal være et heltal på højst {0} bit",
+	"uint":               "Skal være et heltal uden fortegn",
+	"uint:{0}":           "Skal være et heltal uden fortegn på højst {0} bit",
+	"float":              "Skal være et tal",
+	"bool":               "Skal være en boolesk værdi",
+	"in":                 "Værdien skal være en af: [{params}] - [{value}] er givet",
+	"notIn":              "Værdien må ikke være en af: [{params}] - [{value}] er givet",
+	"alpha3Currency":     "Skal være en gyldig alpha-3 valutakode - [{value}] er givet",
+	"alpha2Country":      "Skal være en gyldig alpha-2 landekode - [{value}] er givet",
+	"uuid":               "Skal være en gyldig uuid, som ikke er nul-uuid'en",
+	"zeroableUuid":       "Skal være en gyldig uuid",
+	"regex":              "Skal være en tekststreng, som matcher det regulære udtryk: {0}",
+	"between":            "Skal være et tal mellem {0} og {1}",
+	"phoneNumberE164":    "Skal være et gyldigt e164-telefonnummer med mellemrum mellem landekode og nummer | Format: '+[landekode] [nummer]' | Maks. længde: landekode=3 nummer=12",
+	"min":                "Skal være et tal større end eller lig med {0}",
+	"max":                "Skal være et tal mindre end eller lig med {0}",
+	"maxSize":            "Den maksimalt tilladte størrelse er {0} bytes",
+	"url":                "Skal være en gyldig http/https-url uden port",
+	"ip":                 "Skal være en gyldig ip-adresse",
+	"email":              "Skal være en gyldig e-mailadresse",
+	"json":               "Skal være en gyldig json-tekststreng",
+	"mapKey":             "Skal være en gyldig nøgle i objektet",
+	typeRule:             "Kan ikke indlæses",
+	typeRule + ":{0}":    "Skal være en værdi, som kan konverteres til [{0}]",
+	strictRule:           "Er ikke et kendt felt",
+	duplicateKeyRule:     "Er en gentaget nøgle",
+}
@@ -0,0 +1,295 @@
+package JsonValidator
+
+import (
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// MessageCatalog holds messages replacing the messages of the rules, such as the messages of a locale.
+//
+// A message is found by the rule name along with its params, e.g. "in:DKK,EUR", then by the number of params, e.g. "int:{0}",
+// and finally by the rule name alone. Rules without a message in the catalog keep their own message.
+//
+// Messages can use the placeholders:
+//
+//	{0}, {1}, ...  The params of the rule
+//	{params}       Every param of the rule, separated by commas
+//	{field}        The json key of the value, or the index of an array entry
+//	{fields}       The json keys of the fields named by the params, e.g. of requiredWith
+//	{value}        The json value which was rejected
+//	{path}         The path of the json value
+type MessageCatalog map[string]string
+
+// messagePlaceholder matches a placeholder of a message, such as {0} or {value}.
+var messagePlaceholder = regexp.MustCompile(`\{([a-z]+|[0-9]+)\}`)
+
+// RegisterCatalog adds the messages of the catalog to the messages of the locale, replacing any message of the same key.
+// Catalogs should be registered during startup, before any validation takes place.
+func (validator *Validator) RegisterCatalog(locale string, catalog MessageCatalog) {
+	locale = normalizeLocale(locale)
+	merged := MessageCatalog{}
+
+	for key, message := range validator.catalogs[locale] {
+		merged[key] = message
+	}
+
+	for key, message := range catalog {
+		merged[key] = message
+	}
+
+	validator.catalogs[locale] = merged
+}
+
+// getCatalogs returns the catalogs replacing the messages of the errors of a validation, by priority.
+// The messages given by WithMessages win over the catalog of the most preferred locale having a catalog.
+func (validator *Validator) getCatalogs(options *Options) []MessageCatalog {
+	var catalogs []MessageCatalog
+
+	if options.Messages != nil {
+		catalogs = append(catalogs, options.Messages)
+	}
+
+	if catalog := validator.getCatalog(options.Locales); catalog != nil {
+		catalogs = append(catalogs, catalog)
+	}
+
+	return catalogs
+}
+
+// getCatalog returns the catalog of the most preferred locale with a catalog, or nil to keep the messages of the rules.
+// A locale with a region, such as da-DK, falls back to the catalog of its language.
+func (validator *Validator) getCatalog(locales []string) MessageCatalog {
+	for _, locale := range locales {
+		locale = normalizeLocale(locale)
+
+		if catalog, exists := validator.catalogs[locale]; exists {
+			return catalog
+		}
+
+		if language, _, hasRegion := strings.Cut(locale, "-"); hasRegion {
+			if catalog, exists := validator.catalogs[language]; exists {
+				return catalog
+			}
+		}
+	}
+
+	return nil
+}
+
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// parseAcceptLanguage returns the locales of an Accept-Language header, ordered by their quality with the most preferred first.
+func parseAcceptLanguage(header string) []string {
+	type weightedLocale struct {
+		locale  string
+		quality float64
+	}
+
+	var weighted []weightedLocale
+
+	for _, entry := range strings.Split(header, ",") {
+		locale, params, _ := strings.Cut(entry, ";")
+		locale = strings.TrimSpace(locale)
+		quality := 1.0
+
+		if value, hasQuality := strings.CutPrefix(strings.TrimSpace(params), "q="); hasQuality {
+			parsed, err := strconv.ParseFloat(value, 64)
+
+			if err != nil {
+				continue
+			}
+
+			quality = parsed
+		}
+
+		if locale == "" || locale == "*" || quality <= 0 {
+			continue
+		}
+
+		weighted = append(weighted, weightedLocale{locale: locale, quality: quality})
+	}
+
+	sort.SliceStable(weighted, func(i, j int) bool {
+		return weighted[i].quality > weighted[j].quality
+	})
+
+	locales := make([]string, len(weighted))
+
+	for i, entry := range weighted {
+		locales[i] = entry.locale
+	}
+
+	return locales
+}
+
+// localize replaces the message of the error with the first message found in the catalogs.
+// The context is the one of the value with the error, if any. The messages of its validationMessage tag win over the catalogs,
+// and it is used to find the json keys of fields named by the params.
+func (validator *Validator) localize(catalogs []MessageCatalog, fieldError FieldError, context *ValidationContext) FieldError {
+	if messages := context.fieldMessages(); messages != nil {
+		catalogs = append([]MessageCatalog{messages}, catalogs...)
+	}
+
+	for _, catalog := range catalogs {
+		message, found := catalog.lookup(fieldError.Rule, fieldError.Params)
+
+		if canonicalName, isAlias := aliases[fieldError.Rule]; !found && isAlias {
+			message, found = catalog.lookup(canonicalName, fieldError.Params)
+		}
+
+		if !found {
+			continue
+		}
+
+		// A message using a param the rule was not given is skipped, in favor of the next catalog
+		if rendered, complete := validator.renderMessage(message, fieldError, context); complete {
+			fieldError.Message = rendered
+			break
+		}
+	}
+
+	return fieldError
+}
+
+func (catalog MessageCatalog) lookup(rule string, params []string) (string, bool) {
+	if len(params) > 0 {
+		if message, found := catalog[rule+":"+strings.Join(params, ",")]; found {
+			return message, true
+		}
+
+		placeholders := make([]string, len(params))
+
+		for i := range params {
+			placeholders[i] = "{" + strconv.Itoa(i) + "}"
+		}
+
+		if message, found := catalog[rule+":"+strings.Join(placeholders, ",")]; found {
+			return message, true
+		}
+	}
+
+	message, found := catalog[rule]
+
+	return message, found
+}
+
+// renderMessage replaces the placeholders of the message with the details of the error.
+// Returns false if the message uses a param, which the rule was not given.
+func (validator *Validator) renderMessage(message string, fieldError FieldError, context *ValidationContext) (string, bool) {
+	complete := true
+
+	rendered := messagePlaceholder.ReplaceAllStringFunc(message, func(placeholder string) string {
+		name := placeholder[1 : len(placeholder)-1]
+
+		switch name {
+		case "params":
+			return strings.Join(fieldError.Params, ", ")
+		case "fields":
+			return strings.Join(validator.getNamedFields(fieldError.Params, context), ", ")
+		case "value":
+			return formatMessageValue(fieldError.Value)
+		case "field":
+			return fieldKey(fieldError.Segments)
+		case "path":
+			return fieldError.Path
+		}
+
+		index, err := strconv.Atoi(name)
+
+		if err != nil {
+			// Unknown placeholders are kept as they are
+			return placeholder
+		}
+
+		if index >= len(fieldError.Params) {
+			complete = false
+			return placeholder
+		}
+
+		return fieldError.Params[index]
+	})
+
+	return rendered, complete
+}
+
+// getNamedFields returns the json keys of the neighboring fields, which are named by the params.
+// Params not naming a field, such as the expected value of missingIf, are left out.
+func (validator *Validator) getNamedFields(params []string, context *ValidationContext) []string {
+	var fields []string
+
+	if context == nil || context.Field == nil || context.Field.Parent == nil {
+		return fields
+	}
+
+	for _, param := range params {
+		if neighbor := context.Field.Parent.GetChildByName(param); neighbor != nil {
+			fields = append(fields, neighbor.JsonKey)
+		}
+	}
+
+	return fields
+}
+
+// fieldKey returns the last segment of the path, which is the json key or index of the value.
+func fieldKey(path []PathSegment) string {
+	if len(path) == 0 {
+		return ""
+	}
+
+	return path[len(path)-1].String()
+}
+
+// formatMessageValue formats a parsed json value for a message. Objects and arrays are only described by their type.
+func formatMessageValue(value any) string {
+	switch value := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return value
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	}
+
+	jsonBytes, err := json.Marshal(value)
+
+	if err != nil {
+		return ""
+	}
+
+	return string(jsonBytes)
+}
+
+// fieldMessages returns the messages of the validationMessage tag of the value under validation, if any.
+// Entries of slices and maps have no tag of their own, so they use the messages of the nearest slice or map field holding them.
+// The messages are found through the contexts, since the entries of fields of the same type share their analysis.
+func (context *ValidationContext) fieldMessages() MessageCatalog {
+	for current := context; current != nil && current.Field != nil; current = current.ParentContext {
+		if current.Field.Messages != nil {
+			return current.Field.Messages
+		}
+
+		if !current.isEntry() {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// isEntry reports whether the value under validation is an entry of a slice or map, rather than a field or a key.
+func (context *ValidationContext) isEntry() bool {
+	parent := context.ParentContext
+
+	if parent == nil || parent == context || parent.Field == nil {
+		return false
+	}
+
+	return (parent.Field.IsSlice || parent.Field.IsMap) && context.Field != parent.Field.Key
+}
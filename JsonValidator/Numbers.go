@@ -0,0 +1,177 @@
+package JsonValidator
+
+import (
+	"encoding/json"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// maxNumberExponent limits the exponent of json numbers converted into exact values.
+// Numbers like 1e1000000000 are valid json, but would otherwise allocate huge amounts of memory when converted.
+const maxNumberExponent = 10000
+
+// maxNumberDigits limits the digits of json numbers converted into exact values.
+// Converting a decimal number takes superlinear time in its number of digits, so numbers beyond the limit fail numeric rules instead.
+const maxNumberDigits = 1000
+
+// parseNumber converts a json number into an exact rational value.
+// Values decoded from json are always json.Number, but native Go numbers are supported for values set by custom code.
+func parseNumber(value any) (*big.Rat, bool) {
+	switch number := value.(type) {
+	case json.Number:
+		return parseJsonNumber(number)
+	case float32, float64:
+		float := reflect.ValueOf(number).Float()
+		rat := new(big.Rat)
+
+		// SetFloat64 returns nil for NaN and infinite values, which are not numbers in json
+		if rat.SetFloat64(float) == nil {
+			return nil, false
+		}
+
+		return rat, true
+	case int, int8, int16, int32, int64:
+		return new(big.Rat).SetInt64(reflect.ValueOf(number).Int()), true
+	case uint, uint8, uint16, uint32, uint64, uintptr:
+		return new(big.Rat).SetUint64(reflect.ValueOf(number).Uint()), true
+	}
+
+	return nil, false
+}
+
+// Number returns the value as an exact number, like the numeric rules compare it, or false if the value is no number.
+// Json numbers are given to rules as json.Number, so custom rules should read them through Number or Float64 instead of asserting float64.
+// Numbers with more than 1000 digits, or an exponent beyond 10000, are reported as no number, since converting them would take too long.
+func (jsonContext *JsonContext) Number() (*big.Rat, bool) {
+	return parseNumber(jsonContext.Value)
+}
+
+// Float64 returns the value as the nearest float64, or false if the value is no number or beyond the range of a float64.
+func (jsonContext *JsonContext) Float64() (float64, bool) {
+	if number, isJsonNumber := jsonContext.Value.(json.Number); isJsonNumber {
+		value, err := number.Float64()
+
+		return value, err == nil
+	}
+
+	value, isNumber := parseNumber(jsonContext.Value)
+
+	if !isNumber {
+		return 0, false
+	}
+
+	float, _ := value.Float64()
+
+	return float, true
+}
+
+func parseJsonNumber(number json.Number) (*big.Rat, bool) {
+	integerDigits, fractionDigits, exponent, ok := splitJsonNumber(number)
+
+	if !ok || exponent > maxNumberExponent || exponent < -maxNumberExponent || len(integerDigits)+len(fractionDigits) > maxNumberDigits {
+		return nil, false
+	}
+
+	return new(big.Rat).SetString(string(number))
+}
+
+// isZeroNumber reports whether the value is a number equal to zero.
+// Json numbers are checked by their digits, so no exact value has to be computed for numbers without any rules.
+func isZeroNumber(value any) bool {
+	if number, isJsonNumber := value.(json.Number); isJsonNumber {
+		integerDigits, fractionDigits, _, ok := splitJsonNumber(number)
+
+		return ok && strings.Trim(integerDigits, "0") == "" && strings.Trim(fractionDigits, "0") == ""
+	}
+
+	number, isValid := parseNumber(value)
+
+	return isValid && number.Sign() == 0
+}
+
+// splitJsonNumber splits a json number into its integer digits, fraction digits and exponent.
+func splitJsonNumber(number json.Number) (string, string, int, bool) {
+	text := strings.TrimPrefix(string(number), "-")
+	exponent := 0
+
+	if index := strings.IndexAny(text, "eE"); index >= 0 {
+		parsedExponent, err := strconv.Atoi(strings.TrimPrefix(text[index+1:], "+"))
+
+		if err != nil {
+			return "", "", 0, false
+		}
+
+		text, exponent = text[:index], parsedExponent
+	}
+
+	integerDigits, fractionDigits, _ := strings.Cut(text, ".")
+
+	return integerDigits, fractionDigits, exponent, integerDigits != ""
+}
+
+// integerRange returns the smallest and largest value of a signed or unsigned integer of the given number of bits.
+func integerRange(bits int, signed bool) (*big.Rat, *big.Rat) {
+	if bits <= 0 {
+		panic(invalidSchemaError("Invalid integer size of [%d] bits", bits))
+	}
+
+	if !signed {
+		maxValue := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits)), big.NewInt(1))
+
+		return new(big.Rat), new(big.Rat).SetInt(maxValue)
+	}
+
+	limit := new(big.Int).Lsh(big.NewInt(1), uint(bits-1))
+	minValue := new(big.Int).Neg(limit)
+	maxValue := new(big.Int).Sub(limit, big.NewInt(1))
+
+	return new(big.Rat).SetInt(minValue), new(big.Rat).SetInt(maxValue)
+}
+
+func isNumber(value any) bool {
+	switch value.(type) {
+	case json.Number, float32, float64, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, uintptr:
+		return true
+	}
+
+	return false
+}
+
+// formatNumber returns the shortest exact decimal representation of the number, e.g. 10.50 and 1.05e1 both become 10.5
+func formatNumber(value any) (string, bool) {
+	switch number := value.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(number), 'f', -1, 32), true
+	case float64:
+		return strconv.FormatFloat(number, 'f', -1, 64), true
+	case json.Number:
+		return formatJsonNumber(number), true
+	}
+
+	// Any remaining number is an integer
+	if rat, isValid := parseNumber(value); isValid {
+		return rat.Num().String(), true
+	}
+
+	return "", false
+}
+
+func formatJsonNumber(number json.Number) string {
+	rat, isValid := parseJsonNumber(number)
+
+	// Numbers too large to be converted exactly are kept as they were given
+	if !isValid {
+		return string(number)
+	}
+
+	if rat.IsInt() {
+		return rat.Num().String()
+	}
+
+	// A decimal number never has more fraction digits than given, once the exponent has been applied
+	_, fractionDigits, exponent, _ := splitJsonNumber(number)
+
+	return strings.TrimRight(rat.FloatString(max(len(fractionDigits)-exponent, 1)), "0")
+}
@@ -0,0 +1,155 @@
+package JsonValidator
+
+// Options configures how json is validated.
+// Options given to New apply to every validation of the validator, while options given to Validate only apply to that call.
+type Options struct {
+	RootRules       string // The validation rules for the top-level json value, using the same syntax as the validation tag
+	MaxBodySize     int64  // The maximum number of bytes ValidateReader reads. Zero means no limit
+	Strict          bool   // Rejects keys of json objects which do not match any field of the struct they are unmarshalled into
+	DuplicateKeys   bool   // Rejects keys which are given more than once within the same json object
+	MaxErrors       int    // The number of errors after which validation stops. Zero means no limit
+	SourcePositions bool   // Adds the position of the json value within the json to every error
+
+	PathFormatter PathFormatter   // Formats the json paths of errors. Nil means DottedPath
+	Locales       []string        // The preferred locales of the error messages, most preferred first. Empty means the messages of the rules
+	Messages      MessageCatalog  // Overrides the messages of rules regardless of the locale, see MessageCatalog
+	Redaction     RedactionPolicy // Masks the values of sensitive fields within errors. Nil means RedactAll
+
+	ImplicitTypeRules bool // Derives type rules from the Go types of the fields. Only applies to options given to New
+}
+
+type Option func(options *Options)
+
+// WithRootRules sets the validation rules for the top-level json value, e.g. "required|array|lenMax:100|dive|object".
+func WithRootRules(tagline string) Option {
+	return func(options *Options) {
+		options.RootRules = tagline
+	}
+}
+
+// WithMaxBodySize limits the number of bytes ValidateReader reads, before failing with ErrBodyTooLarge.
+func WithMaxBodySize(bytes int64) Option {
+	return func(options *Options) {
+		options.MaxBodySize = bytes
+	}
+}
+
+// WithStrictMode rejects unknown keys in every json object unmarshalled into a struct.
+// Strict mode can also be enabled for single fields with the strict rule, or for a struct type by embedding Strict.
+func WithStrictMode() Option {
+	return func(options *Options) {
+		options.Strict = true
+	}
+}
+
+// WithDuplicateKeyDetection rejects keys given more than once within the same json object, at any depth.
+// Without it, the last value of a duplicated key is used, just like json.Unmarshal does.
+func WithDuplicateKeyDetection() Option {
+	return func(options *Options) {
+		options.DuplicateKeys = true
+	}
+}
+
+// WithMaxErrors stops the validation once the given number of errors has been found, and only reports those errors.
+// This protects against payloads with a huge number of invalid entries, producing huge error responses.
+func WithMaxErrors(maxErrors int) Option {
+	return func(options *Options) {
+		options.MaxErrors = maxErrors
+	}
+}
+
+// WithImplicitTypeRules derives type rules from the Go type of every field, and merges them with the rules of the validation tag.
+// E.g. an int8 field only accepts integers between -128 and 127, and a time.Time field only accepts RFC 3339 strings.
+// Since the rules become a part of the analysis of each type, the option only applies when given to New.
+func WithImplicitTypeRules() Option {
+	return func(options *Options) {
+		options.ImplicitTypeRules = true
+	}
+}
+
+// WithSourcePositions adds the byte offset, line and column of the json value to every error, see FieldError.Position.
+// Errors of missing values get the position of the nearest parent value, which is present.
+// The positions are only found once the json is invalid, by tokenizing it a second time.
+func WithSourcePositions() Option {
+	return func(options *Options) {
+		options.SourcePositions = true
+	}
+}
+
+// WithPathFormatter formats the json paths of errors and the JsonContext with the formatter, e.g. JsonPointer or JsonPath.
+func WithPathFormatter(formatter PathFormatter) Option {
+	return func(options *Options) {
+		options.PathFormatter = formatter
+	}
+}
+
+// WithLocale translates the error messages with the catalog of the first locale having one, see Validator.RegisterCatalog.
+// Errors keep the messages of the rules when none of the locales has a catalog, or when the catalog has no message for the rule.
+func WithLocale(locales ...string) Option {
+	return func(options *Options) {
+		options.Locales = locales
+	}
+}
+
+// WithAcceptLanguage translates the error messages into the most preferred language of an Accept-Language header, e.g. "da-DK,da;q=0.9,en;q=0.8".
+func WithAcceptLanguage(header string) Option {
+	return WithLocale(parseAcceptLanguage(header)...)
+}
+
+// WithMessages overrides the messages of the rules regardless of the locale, e.g. {"lenBetween": "Must be {0} to {1} characters"}.
+// The messages are found and formatted like the messages of a MessageCatalog, and win over the catalog of the locale.
+// Messages given to a single validation replace the messages given to New.
+func WithMessages(messages MessageCatalog) Option {
+	return func(options *Options) {
+		options.Messages = messages
+	}
+}
+
+// WithRedactionPolicy masks the values of fields marked sensitive with the policy, e.g. RedactLastFour.
+// Without it, the values of sensitive fields are replaced completely, see RedactAll.
+func WithRedactionPolicy(policy RedactionPolicy) Option {
+	return func(options *Options) {
+		options.Redaction = policy
+	}
+}
+
+func (options *Options) pathFormatter() PathFormatter {
+	if options.PathFormatter == nil {
+		return DottedPath
+	}
+
+	return options.PathFormatter
+}
+
+func (options *Options) redactionPolicy() RedactionPolicy {
+	if options.Redaction == nil {
+		return RedactAll
+	}
+
+	return options.Redaction
+}
+
+func newOptions(options []Option) Options {
+	resolved := Options{}
+
+	for _, option := range options {
+		option(&resolved)
+	}
+
+	return resolved
+}
+
+// resolveOptions applies the options of a single validation on top of the options of the validator.
+func (validator *Validator) resolveOptions(options []Option) *Options {
+	if len(options) == 0 {
+		return &validator.options
+	}
+
+	resolved := validator.options
+
+	for _, option := range options {
+		option(&resolved)
+	}
+
+	return &resolved
+}
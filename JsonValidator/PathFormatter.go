@@ -0,0 +1,184 @@
+package JsonValidator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PathFormatter formats the json paths of the values under validation, which are used by the JsonContext and the ErrorBag.
+// A path is built one segment at a time, by appending the segment of a value to the formatted path of its parent.
+type PathFormatter interface {
+	Root() string                                   // The path of the top-level json value
+	Append(path string, segment PathSegment) string // Appends the key or index of a value to the path of its parent
+}
+
+var (
+	DottedPath  PathFormatter = dottedPathFormatter{}      // Formats paths like lines.0.amount. The default, but keys containing dots are ambiguous
+	JsonPointer PathFormatter = jsonPointerPathFormatter{} // Formats paths as RFC 6901 JSON Pointers like /lines/0/amount
+	JsonPath    PathFormatter = jsonPathFormatter{}        // Formats paths as JSONPath like $.lines[0].amount
+)
+
+// jsonPathIdentifier matches the keys, which JSONPath allows in dot notation. Any other key uses bracket notation.
+var jsonPathIdentifier = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+var jsonPointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+var jsonPointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+var jsonPathEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`)
+
+type dottedPathFormatter struct{}
+
+func (formatter dottedPathFormatter) Root() string {
+	return ""
+}
+
+func (formatter dottedPathFormatter) Append(path string, segment PathSegment) string {
+	if path == "" {
+		return segment.String()
+	}
+
+	return path + "." + segment.String()
+}
+
+type jsonPointerPathFormatter struct{}
+
+func (formatter jsonPointerPathFormatter) Root() string {
+	return ""
+}
+
+func (formatter jsonPointerPathFormatter) Append(path string, segment PathSegment) string {
+	return path + "/" + jsonPointerEscaper.Replace(segment.String())
+}
+
+type jsonPathFormatter struct{}
+
+func (formatter jsonPathFormatter) Root() string {
+	return "$"
+}
+
+func (formatter jsonPathFormatter) Append(path string, segment PathSegment) string {
+	switch {
+	case segment.IsIndex():
+		return path + "[" + strconv.Itoa(segment.Index) + "]"
+	case segment.IsWildcard():
+		return path + "[*]"
+	case jsonPathIdentifier.MatchString(segment.Key):
+		return path + "." + segment.Key
+	default:
+		return path + "['" + jsonPathEscaper.Replace(segment.Key) + "']"
+	}
+}
+
+// parsePath splits a path formatted by the formatter into its segments. Every path given to the ErrorBag is parsed by it.
+// Paths of DottedPath and JsonPointer cannot tell indices from keys consisting of digits, so such segments are taken to be indices.
+// Paths of any other formatter are parsed like dotted paths, since their format is unknown.
+func parsePath(formatter PathFormatter, path string) []PathSegment {
+	switch formatter.(type) {
+	case jsonPointerPathFormatter:
+		// The leading slash is optional, so a pointer like lines/0 is not mistaken for a path without its first key
+		keys := strings.Split(strings.TrimPrefix(path, "/"), "/")
+
+		for i, key := range keys {
+			keys[i] = jsonPointerUnescaper.Replace(key)
+		}
+
+		return parseSegments(keys, path == "")
+	case jsonPathFormatter:
+		return parseJsonPath(path)
+	default:
+		return parseSegments(strings.Split(path, "."), path == "")
+	}
+}
+
+func parseSegments(keys []string, isRoot bool) []PathSegment {
+	if isRoot {
+		return nil
+	}
+
+	segments := make([]PathSegment, len(keys))
+
+	for i, key := range keys {
+		if index, err := strconv.Atoi(key); err == nil && index >= 0 && strconv.Itoa(index) == key {
+			segments[i] = IndexSegment(index)
+		} else {
+			segments[i] = KeySegment(key)
+		}
+	}
+
+	return segments
+}
+
+// parseJsonPath splits a path formatted by JsonPath, e.g. $.lines[0]['a.b'], into its segments.
+func parseJsonPath(path string) []PathSegment {
+	var segments []PathSegment
+
+	rest := strings.TrimPrefix(path, "$")
+
+	for rest != "" {
+		switch {
+		case strings.HasPrefix(rest, "['"):
+			key, length := unquoteJsonPathKey(rest[2:])
+			segments = append(segments, KeySegment(key))
+			rest = rest[2+length:]
+		case strings.HasPrefix(rest, "[*]"):
+			segments = append(segments, wildcardSegment())
+			rest = rest[3:]
+		case strings.HasPrefix(rest, "["):
+			// Brackets without a valid index, like [x] or an unterminated [, hold a key
+			index, after, closed := strings.Cut(rest[1:], "]")
+
+			if parsed, err := strconv.Atoi(index); closed && err == nil && parsed >= 0 {
+				segments = append(segments, IndexSegment(parsed))
+			} else {
+				segments = append(segments, KeySegment(index))
+			}
+
+			rest = after
+		default:
+			key := strings.TrimPrefix(rest, ".")
+			end := strings.IndexAny(key, ".[")
+
+			if end < 0 {
+				end = len(key)
+			}
+
+			segments = append(segments, KeySegment(key[:end]))
+			rest = key[end:]
+		}
+	}
+
+	return segments
+}
+
+// unquoteJsonPathKey unescapes the quoted key at the start of the text, and returns the key and the length of the text consumed.
+// The text starts after the opening bracket and quote, and the length includes the closing quote and bracket.
+func unquoteJsonPathKey(text string) (string, int) {
+	var key strings.Builder
+
+	for i := 0; i < len(text); i++ {
+		switch {
+		case text[i] == '\\' && i+1 < len(text):
+			i++
+			key.WriteByte(text[i])
+		case strings.HasPrefix(text[i:], "']"):
+			return key.String(), i + 2
+		default:
+			key.WriteByte(text[i])
+		}
+	}
+
+	return key.String(), len(text)
+}
+
+// formatPath formats the full path from the root to a value.
+func formatPath(formatter PathFormatter, path []PathSegment) string {
+	formatted := formatter.Root()
+
+	for _, segment := range path {
+		formatted = formatter.Append(formatted, segment)
+	}
+
+	return formatted
+}
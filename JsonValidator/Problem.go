@@ -0,0 +1,198 @@
+package JsonValidator
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+)
+
+// ProblemContentType is the content type of RFC 7807 problem details.
+const ProblemContentType = "application/problem+json"
+
+// Problem is an RFC 7807 problem details document, describing why a validation failed.
+type Problem struct {
+	Type     string         `json:"type"`               // A URI identifying the type of problem. Defaults to about:blank
+	Title    string         `json:"title"`              // A short summary of the type of problem
+	Status   int            `json:"status"`             // The http status code of the response
+	Detail   string         `json:"detail,omitempty"`   // A description of this occurrence of the problem
+	Instance string         `json:"instance,omitempty"` // A URI identifying this occurrence of the problem, e.g. a request id
+	Errors   []ProblemError `json:"errors,omitempty"`   // The errors of the json values, when the json failed validation
+
+	// The errors as the invalid-params member from the examples of RFC 7807, for clients only understanding that member
+	InvalidParams []ProblemInvalidParam `json:"invalid-params,omitempty"`
+}
+
+// ProblemError is a single error of a json value within a Problem.
+type ProblemError struct {
+	Path     string          `json:"path"`
+	Rule     string          `json:"rule,omitempty"`
+	Params   []string        `json:"params,omitempty"`
+	Message  string          `json:"message"`
+	Category ErrorCategory   `json:"category,omitempty"`
+	Position *SourcePosition `json:"position,omitempty"`
+	IsKey    bool            `json:"isKey,omitempty"`
+}
+
+// ProblemInvalidParam is a single error of a json value within the invalid-params member of a Problem.
+type ProblemInvalidParam struct {
+	Name   string `json:"name"`   // The path of the json value
+	Reason string `json:"reason"` // The message of the error
+}
+
+// ProblemOption customizes a Problem once it is built from an error, e.g. to set the type or instance.
+type ProblemOption func(problem *Problem)
+
+// WithProblemType sets the URI identifying the type of problem.
+func WithProblemType(uri string) ProblemOption {
+	return func(problem *Problem) {
+		problem.Type = uri
+	}
+}
+
+// WithProblemInstance sets the URI identifying this occurrence of the problem, e.g. a request id.
+func WithProblemInstance(instance string) ProblemOption {
+	return func(problem *Problem) {
+		problem.Instance = instance
+	}
+}
+
+// NewProblem builds the Problem of an error returned by the validator.
+// Failed validation is a 422 listing every error of the ErrorBag, invalid json is a 400, and too large json is a 413.
+// Any other error is a 500, without any details, since the error is not caused by the json.
+func NewProblem(err error, options ...ProblemOption) *Problem {
+	var validation *ErrorBag
+
+	problem := &Problem{Type: "about:blank", Status: http.StatusInternalServerError}
+
+	switch {
+	case errors.As(err, &validation):
+		problem.Status = http.StatusUnprocessableEntity
+		problem.Detail = "The json failed validation"
+		problem.Errors = buildProblemErrors(validation)
+		problem.InvalidParams = buildProblemInvalidParams(problem.Errors)
+	case errors.Is(err, ErrValidationFailed):
+		// Errors of custom unmarshalers fail the validation without a path, and their text may echo the json
+		problem.Status = http.StatusUnprocessableEntity
+		problem.Detail = "The json failed validation"
+	case errors.Is(err, ErrInvalidJSON):
+		problem.Status = http.StatusBadRequest
+		problem.Detail = err.Error()
+	case errors.Is(err, ErrBodyTooLarge):
+		problem.Status = http.StatusRequestEntityTooLarge
+		problem.Detail = err.Error()
+	}
+
+	problem.Title = http.StatusText(problem.Status)
+
+	for _, option := range options {
+		option(problem)
+	}
+
+	return problem
+}
+
+func buildProblemErrors(validation *ErrorBag) []ProblemError {
+	fieldErrors := validation.GetFieldErrors()
+	problemErrors := make([]ProblemError, len(fieldErrors))
+
+	for i, fieldError := range fieldErrors {
+		problemErrors[i] = ProblemError{
+			Path:     fieldError.Path,
+			Rule:     fieldError.Rule,
+			Params:   fieldError.Params,
+			Message:  fieldError.Message,
+			Category: fieldError.Category,
+			Position: fieldError.Position,
+			IsKey:    fieldError.IsKey,
+		}
+	}
+
+	return problemErrors
+}
+
+func buildProblemInvalidParams(problemErrors []ProblemError) []ProblemInvalidParam {
+	invalidParams := make([]ProblemInvalidParam, len(problemErrors))
+
+	for i, problemError := range problemErrors {
+		invalidParams[i] = ProblemInvalidParam{Name: problemError.Path, Reason: problemError.Message}
+	}
+
+	return invalidParams
+}
+
+// Write writes the Problem as the response, with the status of the Problem.
+func (problem *Problem) Write(writer http.ResponseWriter) error {
+	jsonBytes, err := json.Marshal(problem)
+
+	if err != nil {
+		return err
+	}
+
+	writer.Header().Set("Content-Type", ProblemContentType)
+	writer.WriteHeader(problem.Status)
+	_, err = writer.Write(jsonBytes)
+
+	return err
+}
+
+// ParseProblem parses a problem details document, such as the body of a response written by Problem.Write.
+// The errors member is preferred, since it has the rule of each error, while documents only having the invalid-params member
+// from the examples of RFC 7807 get their errors from that member instead.
+func ParseProblem(jsonData []byte) (*Problem, error) {
+	var problem Problem
+
+	if err := json.Unmarshal(jsonData, &problem); err != nil {
+		return nil, err
+	}
+
+	if len(problem.Errors) == 0 {
+		for _, param := range problem.InvalidParams {
+			problem.Errors = append(problem.Errors, ProblemError{Path: param.Name, Message: param.Reason})
+		}
+	}
+
+	return &problem, nil
+}
+
+// ErrorBag returns the errors of the Problem as an ErrorBag, or nil if the Problem has no errors.
+// The paths are kept as they are, while the segments of each path are parsed like the paths given to ErrorBag.Add.
+// The PathFormatter of the bag is the one the paths are formatted by, see detectPathFormatter.
+func (problem *Problem) ErrorBag() *ErrorBag {
+	if len(problem.Errors) == 0 {
+		return nil
+	}
+
+	validation := newErrorBag(0, detectPathFormatter(problem.Errors), nil)
+
+	for _, problemError := range problem.Errors {
+		validation.Add(FieldError{
+			Path:     problemError.Path,
+			Rule:     problemError.Rule,
+			Params:   problemError.Params,
+			Message:  problemError.Message,
+			Category: problemError.Category,
+			Position: problemError.Position,
+			IsKey:    problemError.IsKey,
+		})
+	}
+
+	return validation
+}
+
+// detectPathFormatter returns the PathFormatter of the paths of the errors, which the Problem does not tell by itself.
+// Paths starting with $ are JsonPath, paths starting with / are JsonPointer, and any other paths are DottedPath.
+func detectPathFormatter(problemErrors []ProblemError) PathFormatter {
+	for _, problemError := range problemErrors {
+		switch {
+		case strings.HasPrefix(problemError.Path, "$"):
+			return JsonPath
+		case strings.HasPrefix(problemError.Path, "/"):
+			return JsonPointer
+		case problemError.Path != "":
+			return DottedPath
+		}
+	}
+
+	return DottedPath
+}
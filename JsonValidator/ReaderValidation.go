@@ -0,0 +1,650 @@
+package JsonValidator
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"reflect"
+	"slices"
+)
+
+// ErrBodyTooLarge is returned by ValidateReader when the json exceeds the size set by WithMaxBodySize.
+var ErrBodyTooLarge = errors.New("json body exceeds the maximum allowed size")
+
+// ValidateReader validates json read from the reader, and unmarshals it into dataTarget.
+// Arrays unmarshalled into slices are validated while decoding, one entry at a time, so the array never has to be kept in memory.
+// This applies to a top-level array, and to the arrays of the fields of a top-level object unmarshalled into a struct,
+// as long as the rules of the array itself only depend on its presence and type, e.g. required and array, and not on its entries, e.g. lenMax.
+// Any other json value is read into memory in full and then validated like Validate does. Use WithMaxBodySize to bound the memory used by such values.
+// Validation stops with the error of the context once it is cancelled.
+func (validator *Validator) ValidateReader(ctx context.Context, reader io.Reader, dataTarget any, options ...Option) error {
+	resolvedOptions := validator.resolveOptions(options)
+	fieldCache, err := validator.analyze(reflect.TypeOf(dataTarget), resolvedOptions)
+
+	if err != nil {
+		return err
+	}
+
+	return validator.validateReaderWithFieldCache(ctx, reader, fieldCache, dataTarget, resolvedOptions)
+}
+
+func (validator *Validator) validateReaderWithFieldCache(ctx context.Context, reader io.Reader, fieldCache *FieldCache, dataTarget any, options *Options) (err error) {
+	defer recoverInvalidSchema(&err)
+	defer func() {
+		err = redactSyntaxError(err, fieldCache)
+	}()
+
+	if options.MaxBodySize > 0 {
+		reader = &sizeLimitedReader{reader: reader, remaining: options.MaxBodySize}
+	}
+
+	input := newTrackingReader(reader)
+	bufferedReader := bufio.NewReader(input)
+	firstByte, err := validator.peekFirstNonSpace(bufferedReader)
+
+	if err != nil {
+		return input.convertDecodeError(err)
+	}
+
+	decoder := json.NewDecoder(bufferedReader)
+
+	// The whitespace preceding the json value has already been read
+	input.base = input.end() - int64(bufferedReader.Buffered())
+
+	if firstByte == '[' && validator.canStreamTopLevelArray(fieldCache, dataTarget) {
+		return validator.validateStreamedArray(ctx, decoder, input, fieldCache, dataTarget, options)
+	}
+
+	if firstByte == '{' && validator.canStreamMembers(fieldCache, dataTarget) {
+		return validator.validateStreamedObject(ctx, decoder, input, bufferedReader, fieldCache, dataTarget, options)
+	}
+
+	var jsonData json.RawMessage
+
+	if err := decoder.Decode(&jsonData); err != nil {
+		return input.convertDecodeError(err)
+	}
+
+	// The positions of errors are found within the json value, which may be preceded by whitespace
+	origin := input.sourcePosition(input.decoderOffset(decoder) - int64(len(jsonData)))
+	input.discardBefore(input.decoderOffset(decoder) - 1 - syntaxExcerptRadius)
+
+	if err := validator.verifyEndOfInput(decoder, input); err != nil {
+		return err
+	}
+
+	jsonRaw, err := validator.parseJson(jsonData)
+
+	if err != nil {
+		return err
+	}
+
+	err = validator.validateWithFieldCache(ctx, jsonData, jsonRaw, fieldCache, dataTarget, options)
+
+	if validation, isValidation := err.(*ErrorBag); isValidation {
+		validator.shiftSourcePositions(validation.fieldErrors, origin)
+	}
+
+	return err
+}
+
+// streamedArray is an array, whose entries have been validated and populated one at a time while decoding them.
+type streamedArray struct {
+	validation *ErrorBag      // The errors of the entries, which are only reported once the rules of the array itself have passed
+	entries    reflect.Value  // The populated entries, as long as every entry is valid
+	length     int            // The number of entries
+	position   SourcePosition // The position of the array within the json
+	complete   bool           // False if the decoding stopped before the end of the array
+}
+
+// placeholder stands in for the array while running the rules of the array itself, and the rules of other fields checking whether it is empty.
+func (array *streamedArray) placeholder() []any {
+	return make([]any, array.length)
+}
+
+// streamedMember is the json value of a member of a streamed object, which is kept to find the positions of its errors.
+type streamedMember struct {
+	jsonData []byte // Nil for streamed arrays, whose entries are positioned while streaming them
+	origin   SourcePosition
+}
+
+// canStreamArray reports whether the entries of an array can be validated one at a time, while decoding them.
+// This is only possible when the rules of the array itself depend on nothing but its presence and type,
+// since the errors of any other rule would have to report the full array as their value.
+func (validator *Validator) canStreamArray(field *FieldCache, sliceType reflect.Type) bool {
+	if !field.IsSlice || sliceType.Kind() != reflect.Slice || isUnmarshaler(sliceType) {
+		return false
+	}
+
+	return !slices.ContainsFunc(field.ValidationTag.PresenceRules, validator.dependsOnEntries) &&
+		!slices.ContainsFunc(field.ValidationTag.Rules, validator.dependsOnEntries)
+}
+
+func (validator *Validator) dependsOnEntries(rule *RuleContext) bool {
+	return !rule.IsPresenceRule && !rule.IsNullableRule && !rule.IsTypeRule
+}
+
+func (validator *Validator) canStreamTopLevelArray(fieldCache *FieldCache, dataTarget any) bool {
+	targetType := reflect.TypeOf(dataTarget)
+
+	return targetType.Kind() == reflect.Pointer && validator.canStreamArray(fieldCache, targetType.Elem())
+}
+
+// canStreamMembers reports whether the top-level object has any field holding an array, which can be streamed.
+func (validator *Validator) canStreamMembers(fieldCache *FieldCache, dataTarget any) bool {
+	targetType := reflect.TypeOf(dataTarget)
+
+	if !fieldCache.IsStruct || targetType.Kind() != reflect.Pointer || targetType.Elem().Kind() != reflect.Struct {
+		return false
+	}
+
+	if isUnmarshaler(targetType.Elem()) {
+		return false
+	}
+
+	return slices.ContainsFunc(fieldCache.Children.decodableSet, validator.canStreamField)
+}
+
+func (validator *Validator) canStreamField(field *FieldCache) bool {
+	return validator.canStreamArray(field, field.Reflection)
+}
+
+func isUnmarshaler(valueType reflect.Type) bool {
+	return reflect.PointerTo(valueType).Implements(jsonUnmarshalerType) || reflect.PointerTo(valueType).Implements(textUnmarshalerType)
+}
+
+func (validator *Validator) validateStreamedArray(ctx context.Context, decoder *json.Decoder, input *trackingReader, fieldCache *FieldCache, dataTarget any, options *Options) error {
+	// Consumes the opening bracket of the array
+	if _, err := decoder.Token(); err != nil {
+		return input.convertDecodeError(err)
+	}
+
+	validation := newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options))
+	duplicates := newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options))
+	rootContext := validator.buildRootContext(ctx, fieldCache, []any{}, options)
+	target := reflect.ValueOf(dataTarget).Elem()
+
+	// Once the maximum number of errors is reached, the remaining entries are neither read nor validated.
+	array, err := validator.streamArray(rootContext, decoder, input, "", target.Type(), duplicates, true)
+
+	if err != nil {
+		return err
+	}
+
+	if array.complete {
+		if err := validator.verifyEndOfInput(decoder, input); err != nil {
+			return err
+		}
+	}
+
+	rootContext.Json = validator.buildJsonContextForValue(rootContext.Json.Path, true, array.placeholder())
+	rootContext.streamedArrays = map[*FieldCache]*streamedArray{fieldCache: array}
+
+	return validator.validateStreamedRoot(rootContext, validation, duplicates, array.position, nil, func() error {
+		target.Set(array.entries)
+
+		return nil
+	})
+}
+
+func (validator *Validator) validateStreamedObject(ctx context.Context, decoder *json.Decoder, input *trackingReader, bufferedReader *bufio.Reader, fieldCache *FieldCache, dataTarget any, options *Options) error {
+	// Consumes the opening brace of the object
+	if _, err := decoder.Token(); err != nil {
+		return input.convertDecodeError(err)
+	}
+
+	validation := newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options))
+	duplicates := newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options))
+	jsonObject := map[string]any{}
+	rootContext := validator.buildRootContext(ctx, fieldCache, jsonObject, options)
+	origin := input.sourcePosition(input.decoderOffset(decoder) - 1)
+	arrays := map[*FieldCache]*streamedArray{}
+	members := map[string]streamedMember{}
+	occurrences := map[string]int{}
+
+	// The last key setting each field, since json.Unmarshal keeps the value of the last key matching a field case-insensitively
+	lastKeys := map[*FieldCache]string{}
+
+	// The members which are not streamed are kept as they are, and unmarshalled into the target like any other object
+	remaining := []byte{'{'}
+
+	for hasMembers := false; decoder.More(); hasMembers = true {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		resumeOffset := input.decoderOffset(decoder)
+		prefix := continuationPrefix("", '{', hasMembers)
+		keyToken, err := decoder.Token()
+
+		if err != nil {
+			return input.convertContinuationDecodeError(err, resumeOffset, prefix)
+		}
+
+		key := keyToken.(string)
+		keyPath := []PathSegment{KeySegment(key)}
+		field := fieldCache.Children.getByJsonKey(key)
+		duplicateField, duplicateKey := validator.getDuplicateKeyField(fieldCache, key)
+
+		// The keys of the object are found like the keys of any other object, see validateDuplicateKeys
+		if options.DuplicateKeys {
+			occurrences[duplicateKey]++
+
+			if occurrences[duplicateKey] == 2 {
+				duplicates.addFieldError(validator.buildDuplicateKeyError(duplicates, keyPath))
+			}
+		}
+
+		// The last value of a key given more than once replaces any earlier value
+		delete(arrays, field)
+
+		if setField := fieldCache.Children.getByFoldedJsonKey(key); setField != nil {
+			lastKeys[setField] = key
+		}
+
+		if field != nil && validator.canStreamField(field) && validator.peekValueStart(decoder, input, bufferedReader) == '[' {
+			// Consumes the opening bracket of the array
+			if _, err := decoder.Token(); err != nil {
+				return input.convertContinuationDecodeError(err, resumeOffset, prefix)
+			}
+
+			arrayContext := validator.buildFieldContext(rootContext, field)
+			arrayContext.Json = validator.buildJsonContextForValue(arrayContext.Json.Path, true, nil)
+			arrayPrefix := continuationPrefix("", '{', false) + `"":`
+
+			if hasMembers {
+				arrayPrefix = prefix + `,"":`
+			}
+
+			array, err := validator.streamArray(arrayContext, decoder, input, arrayPrefix, field.Reflection, duplicates, false)
+
+			if err != nil {
+				return err
+			}
+
+			arrays[field] = array
+			jsonObject[key] = array.placeholder()
+			members[key] = streamedMember{origin: array.position}
+
+			continue
+		}
+
+		var jsonData json.RawMessage
+
+		if err := decoder.Decode(&jsonData); err != nil {
+			return input.convertContinuationDecodeError(err, resumeOffset, prefix)
+		}
+
+		memberOrigin := input.sourcePosition(input.decoderOffset(decoder) - int64(len(jsonData)))
+
+		// The input of decoded members is no longer needed, except for the excerpt of a syntax error at its last byte
+		input.discardBefore(input.decoderOffset(decoder) - 1 - syntaxExcerptRadius)
+
+		jsonRaw, err := validator.parseJson(jsonData)
+
+		if err != nil {
+			return err
+		}
+
+		jsonObject[key] = jsonRaw
+		remaining = appendMember(remaining, key, jsonData)
+
+		if options.DuplicateKeys {
+			duplicateCount := len(duplicates.fieldErrors)
+			validator.validateDuplicateKeys(jsonData, keyPath, duplicateField, duplicates)
+
+			// A later value of the same key replaces this value, so the positions of its duplicates are found right away
+			validator.addEntrySourcePositions(jsonData, duplicates.fieldErrors[duplicateCount:], memberOrigin, 1, options)
+		}
+
+		if options.SourcePositions {
+			members[key] = streamedMember{jsonData: jsonData, origin: memberOrigin}
+		}
+	}
+
+	// Consumes the closing brace of the object
+	resumeOffset := input.decoderOffset(decoder)
+
+	if _, err := decoder.Token(); err != nil {
+		return input.convertContinuationDecodeError(err, resumeOffset, continuationPrefix("", '{', len(jsonObject) > 0))
+	}
+
+	if err := validator.verifyEndOfInput(decoder, input); err != nil {
+		return err
+	}
+
+	rootContext.streamedArrays = arrays
+
+	return validator.validateStreamedRoot(rootContext, validation, duplicates, origin, members, func() error {
+		remaining = append(remaining, '}')
+
+		// The streamed arrays are populated from their entries, which are set once the remaining members have been populated
+		if err := validator.populateTarget(remaining, dataTarget); err != nil {
+			if !validator.addUnmarshalError(err, remaining, jsonObject, rootContext, validation) {
+				return err
+			}
+
+			return nil
+		}
+
+		target := reflect.ValueOf(dataTarget).Elem()
+
+		for field, array := range arrays {
+			if lastKeys[field] != field.JsonKey {
+				continue
+			}
+
+			fieldTarget, err := structFieldValue(target, field)
+
+			if err != nil {
+				return err
+			}
+
+			fieldTarget.Set(array.entries)
+		}
+
+		return nil
+	})
+}
+
+// appendMember appends the member to the json object, which is missing its closing brace.
+func appendMember(jsonObject []byte, key string, jsonData []byte) []byte {
+	if len(jsonObject) > 1 {
+		jsonObject = append(jsonObject, ',')
+	}
+
+	// Marshalling a string never fails
+	quotedKey, _ := json.Marshal(key)
+	jsonObject = append(jsonObject, quotedKey...)
+	jsonObject = append(jsonObject, ':')
+
+	return append(jsonObject, jsonData...)
+}
+
+// structFieldValue returns the field of the struct by its index sequence, allocating any embedded struct pointers on the way, like json.Unmarshal does.
+func structFieldValue(target reflect.Value, field *FieldCache) (reflect.Value, error) {
+	for _, index := range field.index {
+		if target.Kind() == reflect.Pointer {
+			if target.IsNil() {
+				// A pointer to an embedded unexported struct cannot be set
+				if !target.CanSet() {
+					return reflect.Value{}, fmt.Errorf("json: cannot set embedded pointer to unexported struct: %v", target.Type().Elem())
+				}
+
+				target.Set(reflect.New(target.Type().Elem()))
+			}
+
+			target = target.Elem()
+		}
+
+		target = target.Field(index)
+	}
+
+	return target, nil
+}
+
+// validateStreamedRoot validates the top-level value, once its streamed arrays have been read, and then populates the target.
+// Populating the target may add unmarshal errors to the validation, like validateWithFieldCache does.
+// Errors which have not been positioned while streaming are found within the members, or get the position of the top-level value.
+func (validator *Validator) validateStreamedRoot(rootContext *ValidationContext, validation *ErrorBag, duplicates *ErrorBag, origin SourcePosition, members map[string]streamedMember, populate func() error) error {
+	validator.validateRoot(rootContext, validation)
+
+	// A cancelled validation is incomplete, so neither the found errors nor the unmarshalled data can be trusted
+	if err := rootContext.Context.Err(); err != nil {
+		return err
+	}
+
+	validation.Merge(duplicates)
+
+	if validation.IsValid() {
+		if err := populate(); err != nil {
+			return err
+		}
+	}
+
+	if validation.IsValid() {
+		return nil
+	}
+
+	if rootContext.Options.SourcePositions {
+		validator.addMemberSourcePositions(validation.fieldErrors, origin, members)
+	}
+
+	return validation
+}
+
+// addMemberSourcePositions sets the positions of the errors, which have not been positioned while streaming.
+// Errors within a member are found within its json value, while any other error gets the position of the top-level value.
+func (validator *Validator) addMemberSourcePositions(fieldErrors []FieldError, origin SourcePosition, members map[string]streamedMember) {
+	memberErrors := map[string][]int{}
+
+	for i := range fieldErrors {
+		if fieldErrors[i].Position != nil {
+			continue
+		}
+
+		position := origin
+		fieldErrors[i].Position = &position
+
+		if len(fieldErrors[i].Segments) == 0 {
+			continue
+		}
+
+		if member, found := members[fieldErrors[i].Segments[0].Key]; found {
+			*fieldErrors[i].Position = member.origin
+
+			if member.jsonData != nil {
+				memberErrors[fieldErrors[i].Segments[0].Key] = append(memberErrors[fieldErrors[i].Segments[0].Key], i)
+			}
+		}
+	}
+
+	for key, indexes := range memberErrors {
+		positioned := make([]FieldError, len(indexes))
+
+		for i, index := range indexes {
+			positioned[i] = fieldErrors[index]
+			positioned[i].Position = nil
+		}
+
+		validator.addSourcePositions(members[key].jsonData, positioned, 1)
+		validator.shiftSourcePositions(positioned, members[key].origin)
+
+		for i, index := range indexes {
+			fieldErrors[index].Position = positioned[i].Position
+		}
+	}
+}
+
+// streamArray validates and populates the entries of the array, whose opening bracket has just been read, one at a time while decoding them.
+// The prefix stands in for the json preceding the array, so syntax errors within the array can be located.
+// Once the maximum number of errors is reached, the remaining entries are only counted, unless stopWhenFull stops the decoding right away.
+func (validator *Validator) streamArray(arrayContext *ValidationContext, decoder *json.Decoder, input *trackingReader, prefix string, sliceType reflect.Type, duplicates *ErrorBag, stopWhenFull bool) (*streamedArray, error) {
+	options := arrayContext.RootContext.Options
+	ctx := arrayContext.RootContext.Context
+	entryField := arrayContext.Field.Children.All()[0]
+
+	// The paths of the errors of an entry start with the path of the array and the index of the entry
+	prefixLength := len(arrayContext.pathSegments()) + 1
+
+	array := &streamedArray{
+		validation: newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options)),
+		entries:    reflect.MakeSlice(sliceType, 0, 0),
+		position:   input.sourcePosition(input.decoderOffset(decoder) - 1),
+	}
+
+	for ; decoder.More(); array.length++ {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		var entryData json.RawMessage
+		resumeOffset := input.decoderOffset(decoder)
+
+		if err := decoder.Decode(&entryData); err != nil {
+			return nil, input.convertContinuationDecodeError(err, resumeOffset, continuationPrefix(prefix, '[', array.length > 0))
+		}
+
+		entryOrigin := input.sourcePosition(input.decoderOffset(decoder) - int64(len(entryData)))
+
+		// The input of decoded entries is no longer needed, except for the excerpt of a syntax error at its last byte
+		input.discardBefore(input.decoderOffset(decoder) - 1 - syntaxExcerptRadius)
+
+		// Once the maximum number of errors is reached, the remaining entries are only counted
+		if array.validation.IsFull() {
+			continue
+		}
+
+		entryRaw, err := validator.parseJson(entryData)
+
+		if err != nil {
+			return nil, err
+		}
+
+		entryErrorCount := len(array.validation.fieldErrors)
+		duplicateCount := len(duplicates.fieldErrors)
+		entryContext := validator.buildStreamedEntryContext(arrayContext, entryField, array.length, entryRaw)
+		validator.validateField(entryContext, array.validation)
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if options.DuplicateKeys {
+			validator.validateDuplicateKeys(entryData, entryContext.pathSegments(), entryField, duplicates)
+		}
+
+		validator.addEntrySourcePositions(entryData, array.validation.fieldErrors[entryErrorCount:], entryOrigin, prefixLength, options)
+		validator.addEntrySourcePositions(entryData, duplicates.fieldErrors[duplicateCount:], entryOrigin, prefixLength, options)
+
+		if array.validation.IsFull() && stopWhenFull {
+			array.length++
+
+			return array, nil
+		}
+
+		// Once any entry is invalid, the target will not be populated, so the remaining entries are only validated.
+		if array.validation.IsInvalid() {
+			continue
+		}
+
+		entry := reflect.New(sliceType.Elem())
+
+		if err := validator.populateTarget(entryData, entry.Interface()); err != nil {
+			if !validator.addUnmarshalError(err, entryData, entryRaw, entryContext, array.validation) {
+				return nil, err
+			}
+
+			validator.addEntrySourcePositions(entryData, array.validation.fieldErrors[entryErrorCount:], entryOrigin, prefixLength, options)
+
+			continue
+		}
+
+		array.entries = reflect.Append(array.entries, entry.Elem())
+	}
+
+	// Consumes the closing bracket of the array
+	resumeOffset := input.decoderOffset(decoder)
+
+	if _, err := decoder.Token(); err != nil {
+		return nil, input.convertContinuationDecodeError(err, resumeOffset, continuationPrefix(prefix, '[', array.length > 0))
+	}
+
+	array.complete = true
+
+	return array, nil
+}
+
+// addEntrySourcePositions sets the positions of the errors of a streamed entry, which starts at the origin.
+// The paths of the errors start with the first prefixLength segments of the path of the entry.
+func (validator *Validator) addEntrySourcePositions(entryData []byte, fieldErrors []FieldError, origin SourcePosition, prefixLength int, options *Options) {
+	if options.SourcePositions {
+		validator.addSourcePositions(entryData, fieldErrors, prefixLength)
+		validator.shiftSourcePositions(fieldErrors, origin)
+	}
+}
+
+func (validator *Validator) buildStreamedEntryContext(parentContext *ValidationContext, fieldCache *FieldCache, index int, jsonValue any) *ValidationContext {
+	context := validator.buildSliceEntryContext(parentContext, fieldCache, index)
+	context.Json = validator.buildJsonContextForValue(context.Json.Path, true, jsonValue)
+
+	return context
+}
+
+// peekValueStart returns the first byte of the value of the member, whose key has just been read, without consuming it.
+// Zero is returned if the value starts beyond the buffered input, which only happens after an unusually long run of whitespace.
+func (validator *Validator) peekValueStart(decoder *json.Decoder, input *trackingReader, bufferedReader *bufio.Reader) byte {
+	for offset := input.decoderOffset(decoder); ; offset++ {
+		// Buffering more of the input makes it part of the kept input, while the decoder still reads it from the buffer
+		if offset == input.end() {
+			if _, err := bufferedReader.Peek(bufferedReader.Buffered() + 1); err != nil {
+				return 0
+			}
+		}
+
+		if character := input.input[offset-input.start]; !isJsonWhitespace(character) && character != ':' {
+			return character
+		}
+	}
+}
+
+func (validator *Validator) peekFirstNonSpace(reader *bufio.Reader) (byte, error) {
+	for {
+		nextByte, err := reader.ReadByte()
+
+		if err != nil {
+			return 0, err
+		}
+
+		if nextByte != ' ' && nextByte != '\t' && nextByte != '\r' && nextByte != '\n' {
+			return nextByte, reader.UnreadByte()
+		}
+	}
+}
+
+// verifyEndOfInput ensures nothing but whitespace follows the top-level json value, just like json.Unmarshal does.
+func (validator *Validator) verifyEndOfInput(decoder *json.Decoder, input *trackingReader) error {
+	valueEnd := input.decoderOffset(decoder)
+
+	if _, err := decoder.Token(); err != io.EOF {
+		var syntaxError *json.SyntaxError
+
+		// Errors of the reader itself, such as ErrBodyTooLarge, are kept as they are
+		if err != nil && err != io.ErrUnexpectedEOF && !errors.As(err, &syntaxError) {
+			return err
+		}
+
+		return input.trailingDataError(valueEnd)
+	}
+
+	return nil
+}
+
+// sizeLimitedReader fails with ErrBodyTooLarge once more than the remaining bytes are read.
+type sizeLimitedReader struct {
+	reader    io.Reader
+	remaining int64
+}
+
+func (limited *sizeLimitedReader) Read(buffer []byte) (int, error) {
+	if limited.remaining < 0 {
+		return 0, ErrBodyTooLarge
+	}
+
+	// Reading a single byte more than allowed is enough to detect that the limit is exceeded
+	if int64(len(buffer)) > limited.remaining+1 {
+		buffer = buffer[:limited.remaining+1]
+	}
+
+	read, err := limited.reader.Read(buffer)
+	limited.remaining -= int64(read)
+
+	if limited.remaining < 0 {
+		return read, ErrBodyTooLarge
+	}
+
+	return read, err
+}
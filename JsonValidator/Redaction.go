@@ -0,0 +1,141 @@
+package JsonValidator
+
+import (
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// RedactionPolicy masks the text of a sensitive json value within errors, e.g. the number of a card.
+// Fields are marked sensitive with the sensitive flag of the validation tag, which also covers the values nested within the field.
+//
+//	type Card struct {
+//		Number string `json:"number" validation:"sensitive|required|string|len:16"`
+//	}
+type RedactionPolicy func(value string) string
+
+// redactedValue replaces sensitive objects and arrays, as well as any value redacted by RedactAll.
+const redactedValue = "<redacted>"
+
+// RedactAll replaces the whole value, without revealing its length.
+func RedactAll(string) string {
+	return redactedValue
+}
+
+// RedactLastFour masks every character but the last four, like the number of a card is usually shown, e.g. ************1111.
+// Values of four characters or less are masked completely.
+func RedactLastFour(value string) string {
+	length := utf8.RuneCountInString(value)
+
+	if length <= 4 {
+		return strings.Repeat("*", length)
+	}
+
+	runes := []rune(value)
+
+	return strings.Repeat("*", length-4) + string(runes[length-4:])
+}
+
+// redact masks the value of the error with the policy, along with any mention of the value within brackets in the message.
+// The built-in rules mention the value given within brackets, e.g. "Value must be in set: [DKK, EUR] - [SEK] given".
+func redact(fieldError FieldError, policy RedactionPolicy) FieldError {
+	switch fieldError.Value.(type) {
+	case nil:
+		return fieldError
+	case map[string]any, []any:
+		fieldError.Value = redactedValue
+		return fieldError
+	}
+
+	text, isScalar := castValueToString(fieldError.Value)
+
+	if !isScalar {
+		fieldError.Value = redactedValue
+		return fieldError
+	}
+
+	masked := policy(text)
+	fieldError.Value = masked
+	fieldError.Message = strings.ReplaceAll(fieldError.Message, "["+text+"]", "["+masked+"]")
+
+	return fieldError
+}
+
+// redactIfSensitive redacts the error of the value under validation, if the value is sensitive.
+func (context *ValidationContext) redactIfSensitive(fieldError FieldError) FieldError {
+	if !context.isSensitive() {
+		return fieldError
+	}
+
+	return redact(fieldError, context.RootContext.Options.redactionPolicy())
+}
+
+// isSensitive reports whether the value under validation, or any value it is nested within, is marked sensitive.
+func (context *ValidationContext) isSensitive() bool {
+	for current := context; current != nil; current = current.ParentContext {
+		if current.ValidationTag != nil && current.ValidationTag.Sensitive {
+			return true
+		}
+
+		if current.ParentContext == current {
+			break
+		}
+	}
+
+	return false
+}
+
+// hasSensitiveField reports whether the field, or any value nested within it, is marked sensitive.
+// Fields of recursive types share their children, so every field is only visited once.
+func (fieldCache *FieldCache) hasSensitiveField() bool {
+	return fieldCache.hasSensitiveFieldVisiting(map[*FieldCache]bool{})
+}
+
+func (fieldCache *FieldCache) hasSensitiveFieldVisiting(visited map[*FieldCache]bool) bool {
+	if visited[fieldCache] {
+		return false
+	}
+
+	visited[fieldCache] = true
+
+	if fieldCache.ValidationTag.hasSensitiveRules() {
+		return true
+	}
+
+	return slices.ContainsFunc(fieldCache.Children.All(), func(child *FieldCache) bool {
+		return child.hasSensitiveFieldVisiting(visited)
+	})
+}
+
+// hasSensitiveRules reports whether the tag marks the field, its entries or its keys sensitive.
+func (tag *ValidationTag) hasSensitiveRules() bool {
+	return tag != nil && (tag.Sensitive || tag.Dive.hasSensitiveRules() || tag.Keys.hasSensitiveRules())
+}
+
+// isSensitiveAt reports whether the value at the path relative to the field is marked sensitive, or nested within a sensitive value.
+func (fieldCache *FieldCache) isSensitiveAt(path []PathSegment) bool {
+	field := fieldCache
+
+	for _, segment := range path {
+		if field.ValidationTag != nil && field.ValidationTag.Sensitive {
+			return true
+		}
+
+		children := field.Children.All()
+
+		switch {
+		case field.IsStruct:
+			field = field.GetChildByJsonKey(segment.String())
+		case (field.IsSlice || field.IsMap) && len(children) > 0:
+			field = children[0]
+		default:
+			field = nil
+		}
+
+		if field == nil {
+			return false
+		}
+	}
+
+	return field.ValidationTag != nil && field.ValidationTag.Sensitive
+}
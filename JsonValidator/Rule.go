@@ -8,12 +8,27 @@ type Rule struct {
 	Name           string
 	IsPresenceRule bool
 	IsNullableRule bool
+	IsTypeRule     bool // Tells the failure of the rule is a TypeCategory error, e.g. a string given for an int rule
 	Function       RuleFunction
 }
 
+// category is the category of the errors of the rule.
+func (rule Rule) category() ErrorCategory {
+	switch {
+	case rule.IsPresenceRule:
+		return PresenceCategory
+	case rule.IsTypeRule:
+		return TypeCategory
+	default:
+		return ValueCategory
+	}
+}
+
 type RuleContext struct {
 	Rule
 	Params []string
+
+	skipAfterFailure bool // Skips the rule once an earlier rule of the field has failed, see withImplicitTypeRules
 }
 
 func (context *RuleContext) GetStringParam(index int) string {
@@ -24,7 +39,7 @@ func (context *RuleContext) GetIntParam(index int) int {
 	value, err := strconv.Atoi(context.Params[index])
 
 	if err != nil {
-		panic(err)
+		panic(invalidSchemaError("Invalid integer param [%s] for rule [%s]", context.Params[index], context.Name))
 	}
 
 	return value
@@ -34,7 +49,7 @@ func (context *RuleContext) GetFloatParam(index int) float64 {
 	value, err := strconv.ParseFloat(context.Params[index], 64)
 
 	if err != nil {
-		panic(err)
+		panic(invalidSchemaError("Invalid float param [%s] for rule [%s]", context.Params[index], context.Name))
 	}
 
 	return value
@@ -15,7 +15,7 @@ type Rulebook struct {
 	composites map[string]string
 }
 
-func newRulebook(rules ruleFunctionList, nullableRules []string, presenceRules []string, aliases map[string]string) *Rulebook {
+func newRulebook(rules ruleFunctionList, nullableRules []string, presenceRules []string, typeRules []string, aliases map[string]string) *Rulebook {
 	rulebook := &Rulebook{
 		rules:      make(map[string]Rule),
 		composites: make(map[string]string),
@@ -27,6 +27,7 @@ func newRulebook(rules ruleFunctionList, nullableRules []string, presenceRules [
 			Function:       rule,
 			IsPresenceRule: slices.Contains(presenceRules, name),
 			IsNullableRule: slices.Contains(nullableRules, name),
+			IsTypeRule:     slices.Contains(typeRules, name),
 		})
 	}
 
@@ -50,6 +51,7 @@ func (rulebook Rulebook) RegisterAlias(alias string, name string) Rulebook {
 		Name:           alias,
 		IsPresenceRule: rule.IsPresenceRule,
 		IsNullableRule: rule.IsNullableRule,
+		IsTypeRule:     rule.IsTypeRule,
 		Function:       rule.Function,
 	})
 
@@ -73,7 +75,7 @@ func (rulebook Rulebook) GetComposite(ruleDefinition string) string {
 	name, params := rulebook.parseRuleDefinition(ruleDefinition)
 	compositeRule, exists := rulebook.composites[name]
 	if !exists {
-		panic(fmt.Sprintf("No registered composite for name %s", name))
+		panic(invalidSchemaError("No registered composite for name %s", name))
 	}
 
 	// Replace placeholders with provided params
@@ -100,7 +102,7 @@ func (rulebook Rulebook) getRuleDefinition(name string) Rule {
 		return rule
 	}
 
-	panic(fmt.Sprintf("No registered rule for name [%s]", name))
+	panic(invalidSchemaError("No registered rule for name [%s]", name))
 }
 
 func (rulebook Rulebook) parseRuleDefinition(ruleDefinition string) (string, []string) {
@@ -1,10 +1,12 @@
 package JsonValidator
 
 import (
+	"encoding"
 	"encoding/json"
 	"fmt"
 	"github.com/epay-technology/package-conversions-go/CountryCode"
 	"github.com/epay-technology/package-conversions-go/CurrencyCode"
+	"math/big"
 	"net"
 	"net/url"
 	"reflect"
@@ -39,11 +41,13 @@ var rules = map[string]RuleFunction{
 	"requiredWithoutAll": requiredWithoutAll,
 	"requireOneInGroup":  requireOneInGroup,
 	"date":               isDate,
+	"rfc3339":            isRfc3339,
 	"array":              isArray,
 	"object":             isObject,
 	"objectMissingKeys":  isObjectMissingKeys,
 	"string":             isString,
 	"int":                isInteger,
+	"uint":               isUnsignedInteger,
 	"float":              isFloat,
 	"bool":               isBool,
 	"in":                 isIn,
@@ -62,6 +66,7 @@ var rules = map[string]RuleFunction{
 	"ip":                 isIp,
 	"email":              isEmail,
 	"json":               isJson,
+	"mapKey":             isMapKey,
 }
 
 var aliases = map[string]string{
@@ -72,11 +77,23 @@ var aliases = map[string]string{
 	"integer": "int",
 }
 
+var textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+
 var nullableRules = []string{
 	"nullable",
 	"nilable",
 }
 
+var typeRules = []string{
+	"array",
+	"object",
+	"string",
+	"int",
+	"uint",
+	"float",
+	"bool",
+}
+
 var presenceRules = []string{
 	"present",
 	"required",
@@ -128,7 +145,7 @@ func missingUnless(context *FieldValidationContext) (string, bool) {
 
 	neighbor, ok := context.Validation.GetNeighborField(sibling)
 	if !ok {
-		panic(fmt.Sprintf("No such field within struct: %s - Remember: Cross field references must use the struct name, and not the json name", sibling))
+		panic(invalidSchemaError("No such field within struct: %s - Remember: Cross field references must use the struct name, and not the json name", sibling))
 	}
 
 	message := fmt.Sprintf("Must not be present unless [%s] has value [%s]", neighbor.FieldName, expectedSiblingValue)
@@ -369,7 +386,7 @@ func isNeighborsPresent(context *FieldValidationContext, fields []string, all, a
 		neighbor, ok := context.Validation.GetNeighborField(field)
 
 		if !ok {
-			panic(fmt.Sprintf("No such field within struct: %s - Remember: Cross field references must use the struct name, and not the json name", field))
+			panic(invalidSchemaError("No such field within struct: %s - Remember: Cross field references must use the struct name, and not the json name", field))
 		}
 
 		fieldPresent := neighbor.Json.KeyPresent
@@ -566,7 +583,8 @@ func isObjectMissingKeys(context *FieldValidationContext) (string, bool) {
 func isString(context *FieldValidationContext) (string, bool) {
 	errorMessage := "Must be a string"
 
-	if context.Validation.Json.IsNull {
+	// Numbers are decoded as json.Number, which is a string type, but not a json string
+	if context.Validation.Json.IsNull || isNumber(context.Validation.Json.Value) {
 		return errorMessage, false
 	}
 
@@ -577,30 +595,36 @@ func isString(context *FieldValidationContext) (string, bool) {
 }
 
 func isInteger(context *FieldValidationContext) (string, bool) {
-	errorMessage := "Must be an integer"
+	return integerHelper(context, "Must be an integer", true)
+}
 
-	if context.Validation.Json.IsNull || !context.Validation.Json.KeyPresent {
-		return errorMessage, false
-	}
+func isUnsignedInteger(context *FieldValidationContext) (string, bool) {
+	return integerHelper(context, "Must be an unsigned integer", false)
+}
 
-	valueReflection := reflect.ValueOf(context.Validation.Json.Value)
-	valueKind := valueReflection.Kind()
-	isValidKind := slices.Contains([]reflect.Kind{
-		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-	}, valueKind)
+// integerHelper verifies the value is an integer, which fits into an integer of the optional number of bits given as param.
+func integerHelper(context *FieldValidationContext, errorMessage string, signed bool) (string, bool) {
+	var minValue, maxValue *big.Rat
 
-	if isValidKind {
-		return "", true
+	if len(context.Params) > 0 {
+		minValue, maxValue = integerRange(context.GetIntParam(0), signed)
+		errorMessage = fmt.Sprintf("Must be an integer between %s and %s", minValue.Num().String(), maxValue.Num().String())
+	} else if !signed {
+		minValue = new(big.Rat)
+	}
+
+	if context.Validation.Json.IsNull || !context.Validation.Json.KeyPresent {
+		return errorMessage, false
 	}
 
-	floatVal, ok := valueReflection.Interface().(float64)
+	// Numbers with a zero fraction, such as 1.0, are also considered integers
+	value, isNumber := parseNumber(context.Validation.Json.Value)
 
-	if !ok {
+	if !isNumber || !value.IsInt() {
 		return errorMessage, false
 	}
 
-	return errorMessage, floatVal == float64(int(floatVal))
+	return errorMessage, (minValue == nil || minValue.Cmp(value) <= 0) && (maxValue == nil || value.Cmp(maxValue) <= 0)
 }
 
 func isFloat(context *FieldValidationContext) (string, bool) {
@@ -610,15 +634,8 @@ func isFloat(context *FieldValidationContext) (string, bool) {
 		return errorMessage, false
 	}
 
-	valueKind := reflect.Indirect(reflect.ValueOf(context.Validation.Json.Value)).Kind()
-	isValidKind := slices.Contains([]reflect.Kind{
-		// Int is here considered a subset of the float value space
-		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-		reflect.Float32, reflect.Float64,
-	}, valueKind)
-
-	return errorMessage, isValidKind
+	// Int is here considered a subset of the float value space
+	return errorMessage, isNumber(context.Validation.Json.Value)
 }
 
 func isIn(context *FieldValidationContext) (string, bool) {
@@ -674,14 +691,8 @@ func castValueToString(jsonValue any) (string, bool) {
 	valueFound := true
 
 	// Extract the actual value from the json
-	if value, isInt := jsonValue.(int); isInt {
-		actualValue = strconv.Itoa(value)
-	} else if value, isFloat := jsonValue.(float64); isFloat {
-		if value == 0 {
-			actualValue = "0"
-		} else {
-			actualValue = strings.TrimRight(fmt.Sprintf("%f", value), "0.")
-		}
+	if value, isNumber := formatNumber(jsonValue); isNumber {
+		actualValue = value
 	} else if value, isString := jsonValue.(string); isString {
 		actualValue = value
 	} else if value, isString := jsonValue.(bool); isString {
@@ -840,6 +851,17 @@ func isDate(context *FieldValidationContext) (string, bool) {
 	return fmt.Sprintf("%s - Got: [%s]", errorMessage, value), err == nil
 }
 
+func isRfc3339(context *FieldValidationContext) (string, bool) {
+	errorMessage := "Must be a valid RFC 3339 date-time formatted string"
+	value, ok := context.Validation.Json.Value.(string)
+	if !ok {
+		return errorMessage + " - Non string given", false
+	}
+
+	_, err := time.Parse(time.RFC3339, value)
+	return fmt.Sprintf("%s - Got: [%s]", errorMessage, value), err == nil
+}
+
 func isE164PhoneNumber(context *FieldValidationContext) (string, bool) {
 	errorMessage := "Must be a valid e164 phone number string with a space between country code and number | Format: '+[countryCode] [number]' | Max length: countryCode=3 number=12"
 
@@ -851,44 +873,44 @@ func isE164PhoneNumber(context *FieldValidationContext) (string, bool) {
 }
 
 func isBetween(context *FieldValidationContext) (string, bool) {
-	minValue := context.GetFloatParam(0)
-	maxValue := context.GetFloatParam(1)
+	minValue := context.GetNumberParam(0)
+	maxValue := context.GetNumberParam(1)
 
 	errorMessage := fmt.Sprintf("Must be a number between %s and %s", context.GetParam(0), context.GetParam(1))
 
-	value, isNumber := convertJsonValueToNumber(context)
+	value, isNumber := parseNumber(context.Validation.Json.Value)
 
 	if !isNumber {
 		return errorMessage, false
 	}
 
-	return errorMessage, minValue <= value && value <= maxValue
+	return errorMessage, minValue.Cmp(value) <= 0 && value.Cmp(maxValue) <= 0
 }
 
 func isMin(context *FieldValidationContext) (string, bool) {
-	minValue := context.GetFloatParam(0)
+	minValue := context.GetNumberParam(0)
 	errorMessage := fmt.Sprintf("Must be a number greater than or equal to %s", context.GetParam(0))
 
-	value, isNumber := convertJsonValueToNumber(context)
+	value, isNumber := parseNumber(context.Validation.Json.Value)
 
 	if !isNumber {
 		return errorMessage, false
 	}
 
-	return errorMessage, minValue <= value
+	return errorMessage, minValue.Cmp(value) <= 0
 }
 
 func isMax(context *FieldValidationContext) (string, bool) {
-	maxValue := context.GetFloatParam(0)
+	maxValue := context.GetNumberParam(0)
 	errorMessage := fmt.Sprintf("Must be a number less than or equal to %s", context.GetParam(0))
 
-	value, isNumber := convertJsonValueToNumber(context)
+	value, isNumber := parseNumber(context.Validation.Json.Value)
 
 	if !isNumber {
 		return errorMessage, false
 	}
 
-	return errorMessage, value <= maxValue
+	return errorMessage, value.Cmp(maxValue) <= 0
 }
 
 func maxSize(context *FieldValidationContext) (string, bool) {
@@ -904,25 +926,35 @@ func maxSize(context *FieldValidationContext) (string, bool) {
 	return fmt.Sprintf("The maximum allowed size is %d bytes, got %d bytes", maxSize, actualSize), actualSize <= maxSize
 }
 
-func convertJsonValueToNumber(context *FieldValidationContext) (float64, bool) {
-	reflection := reflect.ValueOf(context.Validation.Json.Value)
+func isMapKey(context *FieldValidationContext) (string, bool) {
+	keyType := context.Validation.Field.Reflection
+	errorMessage := fmt.Sprintf("Must be a key convertible to [%s]", keyType.String())
 
-	isFloat := slices.Contains([]reflect.Kind{reflect.Float32, reflect.Float64}, reflection.Kind())
+	key, isString := context.Validation.Json.Value.(string)
 
-	if isFloat {
-		return reflection.Float(), true
+	if !isString {
+		return errorMessage, false
 	}
 
-	isInt := slices.Contains([]reflect.Kind{
-		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
-	}, reflection.Kind())
+	// This follows the same order of precedence as json.Unmarshal uses for map keys
+	if reflect.PointerTo(keyType).Implements(textUnmarshalerType) {
+		unmarshaler := reflect.New(keyType).Interface().(encoding.TextUnmarshaler)
+
+		return errorMessage, unmarshaler.UnmarshalText([]byte(key)) == nil
+	}
 
-	if isInt {
-		return float64(reflection.Int()), true
+	switch keyType.Kind() {
+	case reflect.String:
+		return errorMessage, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		_, err := strconv.ParseInt(key, 10, keyType.Bits())
+		return errorMessage, err == nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		_, err := strconv.ParseUint(key, 10, keyType.Bits())
+		return errorMessage, err == nil
 	}
 
-	return 0, false
+	return errorMessage, false
 }
 
 func verifyRegex(context *FieldValidationContext, regexString string) bool {
@@ -971,7 +1003,8 @@ func lenHelper(context *FieldValidationContext, min *int, max *int) (string, boo
 
 	valueType := reflect.ValueOf(context.Validation.Json.Value)
 
-	if !slices.Contains([]reflect.Kind{reflect.Slice, reflect.Map, reflect.Array, reflect.String}, valueType.Kind()) {
+	// Numbers are decoded as json.Number, which is a string type, but not countable in json
+	if !slices.Contains([]reflect.Kind{reflect.Slice, reflect.Map, reflect.Array, reflect.String}, valueType.Kind()) || isNumber(context.Validation.Json.Value) {
 		return errorText, false
 	}
 
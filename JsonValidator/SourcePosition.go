@@ -0,0 +1,166 @@
+package JsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// SourcePosition is the position of a json value within the validated json.
+type SourcePosition struct {
+	Offset int64 `json:"offset"` // The number of bytes before the first byte of the value
+	Line   int   `json:"line"`   // The line of the first byte of the value, starting from 1
+	Column int   `json:"column"` // The column of the first byte of the value counted in bytes, starting from 1
+}
+
+// relativeTo translates a position within a json value into a position within the json, when the value starts at the origin.
+func (position SourcePosition) relativeTo(origin SourcePosition) SourcePosition {
+	if position.Line == 1 {
+		position.Column += origin.Column - 1
+	}
+
+	position.Line += origin.Line - 1
+	position.Offset += origin.Offset
+
+	return position
+}
+
+// positionLocator finds the positions of the json values with errors, by tokenizing the json once.
+// Values are identified by their JSON Pointer, which is unambiguous within a single json document.
+// Only the values with errors and their parents are traversed, while any other value is skipped.
+type positionLocator struct {
+	jsonData  []byte
+	decoder   *json.Decoder
+	wanted    map[string]bool           // The values to locate, along with all of their parents
+	found     map[string]SourcePosition // The positions of the located values
+	cursor    int64                     // The offset up to which the lines have been counted
+	line      int                       // The line of the cursor, starting from 1
+	lineStart int64                     // The offset of the first byte of the line of the cursor
+}
+
+// addSourcePositions sets the position of the value of every error, found within the json value of jsonData.
+// The paths of the errors are relative to the json value, once the first prefixLength segments are removed.
+// Errors of missing values get the position of the nearest parent value, which is present.
+func (validator *Validator) addSourcePositions(jsonData []byte, fieldErrors []FieldError, prefixLength int) {
+	if len(fieldErrors) == 0 {
+		return
+	}
+
+	locator := &positionLocator{
+		jsonData: jsonData,
+		decoder:  json.NewDecoder(bytes.NewReader(jsonData)),
+		wanted:   map[string]bool{},
+		found:    map[string]SourcePosition{},
+		line:     1,
+	}
+
+	for _, fieldError := range fieldErrors {
+		path := fieldError.Segments[min(prefixLength, len(fieldError.Segments)):]
+
+		for i := 0; i <= len(path); i++ {
+			locator.wanted[formatPath(JsonPointer, path[:i])] = true
+		}
+	}
+
+	// The json has already been parsed successfully at this point, so tokenizing it cannot fail
+	_ = locator.locate(JsonPointer.Root())
+
+	for i := range fieldErrors {
+		path := fieldErrors[i].Segments[min(prefixLength, len(fieldErrors[i].Segments)):]
+
+		for length := len(path); length >= 0; length-- {
+			if position, found := locator.found[formatPath(JsonPointer, path[:length])]; found {
+				fieldErrors[i].Position = &position
+				break
+			}
+		}
+	}
+}
+
+func (validator *Validator) addSourcePositionsIfEnabled(jsonData []byte, fieldErrors []FieldError, prefixLength int, options *Options) {
+	if options.SourcePositions {
+		validator.addSourcePositions(jsonData, fieldErrors, prefixLength)
+	}
+}
+
+// shiftSourcePositions translates the positions of errors within a json value, into positions within the json, when the value starts at the origin.
+func (validator *Validator) shiftSourcePositions(fieldErrors []FieldError, origin SourcePosition) {
+	for _, fieldError := range fieldErrors {
+		if fieldError.Position != nil {
+			*fieldError.Position = fieldError.Position.relativeTo(origin)
+		}
+	}
+}
+
+func (locator *positionLocator) locate(pointer string) error {
+	locator.found[pointer] = locator.position(locator.valueStart())
+
+	token, err := locator.decoder.Token()
+
+	if err != nil {
+		return err
+	}
+
+	delimiter, isDelimiter := token.(json.Delim)
+
+	if !isDelimiter {
+		return nil
+	}
+
+	for index := 0; locator.decoder.More(); index++ {
+		entryPointer := JsonPointer.Append(pointer, IndexSegment(index))
+
+		if delimiter == '{' {
+			keyToken, err := locator.decoder.Token()
+
+			if err != nil {
+				return err
+			}
+
+			entryPointer = JsonPointer.Append(pointer, KeySegment(keyToken.(string)))
+		}
+
+		if err := locator.locateOrSkip(entryPointer); err != nil {
+			return err
+		}
+	}
+
+	// Consumes the closing delimiter of the object or array
+	_, err = locator.decoder.Token()
+
+	return err
+}
+
+func (locator *positionLocator) locateOrSkip(pointer string) error {
+	if locator.wanted[pointer] {
+		return locator.locate(pointer)
+	}
+
+	var skipped json.RawMessage
+
+	return locator.decoder.Decode(&skipped)
+}
+
+// valueStart returns the offset of the next value, skipping the whitespace and separators preceding it.
+func (locator *positionLocator) valueStart() int64 {
+	offset := locator.decoder.InputOffset()
+
+	for offset < int64(len(locator.jsonData)) && (isJsonWhitespace(locator.jsonData[offset]) || locator.jsonData[offset] == ':' || locator.jsonData[offset] == ',') {
+		offset++
+	}
+
+	return offset
+}
+
+// position returns the position of the offset, counting the lines since the previous position.
+func (locator *positionLocator) position(offset int64) SourcePosition {
+	skipped := locator.jsonData[locator.cursor:offset]
+
+	if lines := bytes.Count(skipped, []byte{'\n'}); lines > 0 {
+		locator.line += lines
+		locator.lineStart = locator.cursor + int64(bytes.LastIndexByte(skipped, '\n')) + 1
+	}
+
+	locator.cursor = offset
+
+	return SourcePosition{Offset: offset, Line: locator.line, Column: int(offset-locator.lineStart) + 1}
+}
@@ -0,0 +1,49 @@
+package JsonValidator
+
+import (
+	"reflect"
+	"sort"
+)
+
+// Strict rejects unknown keys in the json objects unmarshalled into a struct, when embedded in the struct.
+//
+//	type Payment struct {
+//		JsonValidator.Strict
+//		Amount int `json:"amount" validation:"required|integer"`
+//	}
+type Strict struct{}
+
+var strictType = reflect.TypeOf(Strict{})
+
+// validateUnknownKeys reports every key of the json object, which does not match any field of the struct.
+// Fields of embedded structs are a part of the struct itself, and are therefor known keys as well.
+// Fields json never sets, like unexported fields and fields with a "-" json tag, are not known keys.
+func (validator *Validator) validateUnknownKeys(context *ValidationContext, validation *ErrorBag) {
+	jsonObject, validStructJson := context.Json.Value.(map[string]any)
+
+	if !validStructJson {
+		return
+	}
+
+	var unknownKeys []string
+
+	for key := range jsonObject {
+		if context.Field.Children.getByJsonKey(key) == nil {
+			unknownKeys = append(unknownKeys, key)
+		}
+	}
+
+	// Sorted, so the errors are reported in the same order for every validation
+	sort.Strings(unknownKeys)
+
+	for _, key := range unknownKeys {
+		validation.addFieldError(validator.localize(validation.catalogs, context.redactIfSensitive(FieldError{
+			Path:     validator.getPathForStringKey(context, key),
+			Segments: appendSegment(context.pathSegments(), KeySegment(key)),
+			Rule:     strictRule,
+			Message:  "Is not a known field",
+			Value:    jsonObject[key],
+			Category: StructureCategory,
+		}), nil))
+	}
+}
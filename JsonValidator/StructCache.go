@@ -1,9 +1,9 @@
 package JsonValidator
 
 import (
-	"errors"
 	"fmt"
 	"reflect"
+	"slices"
 	"strings"
 	"sync"
 )
@@ -11,6 +11,7 @@ import (
 type FieldCache struct {
 	Parent        *FieldCache
 	Children      *Children
+	Key           *FieldCache // The keys of a map field. Nil for any other kind of field
 	Reflection    reflect.Type
 	JsonKey       string
 	StructKey     string
@@ -18,10 +19,21 @@ type FieldCache struct {
 	IsStruct      bool
 	IsSlice       bool
 	IsMap         bool
+	IsStrict      bool           // True if unknown keys in the json object of a struct field must be rejected
+	Messages      MessageCatalog // The messages of the validationMessage tag, replacing the messages of the rules of the field. Entries use the messages of their slice or map
+
+	// The following describe how json.Unmarshal sets the field
+	index     []int // The index sequence of the field within its struct, like reflect.StructField.Index
+	decodable bool  // True if json.Unmarshal sets the field, meaning it is exported and not ignored by a "-" json tag
+	tagged    bool  // True if the json key is given by the json tag
 }
 
 type Children struct {
 	list []*FieldCache
+
+	// The decodable fields of a struct by their json key, after resolving conflicting keys of embedded structs like json does
+	byJsonKey    map[string]*FieldCache
+	decodableSet []*FieldCache // The fields of byJsonKey in the order of the struct
 }
 
 func (children *Children) Append(field ...*FieldCache) {
@@ -32,16 +44,113 @@ func (children *Children) All() []*FieldCache {
 	return children.list
 }
 
+// indexJsonKeys resolves which field json.Unmarshal sets for each json key of a struct.
+// Like json, the least nested field wins a conflict, then the field with a json tag, and otherwise the key is ignored.
+func (children *Children) indexJsonKeys() {
+	children.byJsonKey = map[string]*FieldCache{}
+	children.decodableSet = []*FieldCache{}
+	candidates := map[string][]*FieldCache{}
+	var keys []string
+
+	for _, child := range children.list {
+		if !child.decodable {
+			continue
+		}
+
+		if _, seen := candidates[child.JsonKey]; !seen {
+			keys = append(keys, child.JsonKey)
+		}
+
+		candidates[child.JsonKey] = append(candidates[child.JsonKey], child)
+	}
+
+	for _, key := range keys {
+		if dominant := dominantField(candidates[key]); dominant != nil {
+			children.byJsonKey[key] = dominant
+			children.decodableSet = append(children.decodableSet, dominant)
+		}
+	}
+}
+
+func dominantField(fields []*FieldCache) *FieldCache {
+	var dominant []*FieldCache
+
+	for _, field := range fields {
+		if len(dominant) == 0 || len(field.index) < len(dominant[0].index) {
+			dominant = []*FieldCache{field}
+		} else if len(field.index) == len(dominant[0].index) {
+			dominant = append(dominant, field)
+		}
+	}
+
+	if len(dominant) == 1 {
+		return dominant[0]
+	}
+
+	var tagged []*FieldCache
+
+	for _, field := range dominant {
+		if field.tagged {
+			tagged = append(tagged, field)
+		}
+	}
+
+	if len(tagged) == 1 {
+		return tagged[0]
+	}
+
+	return nil
+}
+
+// getByJsonKey returns the field json.Unmarshal sets for the key, or nil if json ignores the key.
+// Unlike json, the key must match exactly, since the validation rules only apply to the exact key.
+func (children *Children) getByJsonKey(key string) *FieldCache {
+	return children.byJsonKey[key]
+}
+
+// getByFoldedJsonKey returns the field json.Unmarshal sets for the key, or nil if json ignores the key.
+// Like json, an exact match is preferred, and otherwise the first field matching the key case-insensitively is used.
+func (children *Children) getByFoldedJsonKey(key string) *FieldCache {
+	if field, found := children.byJsonKey[key]; found {
+		return field
+	}
+
+	for _, field := range children.decodableSet {
+		if strings.EqualFold(field.JsonKey, key) {
+			return field
+		}
+	}
+
+	return nil
+}
+
 type intermediateCache map[reflect.Type]*FieldCache
 
 type StructCache struct {
 	Cache     map[reflect.Type]*FieldCache
+	rootCache map[rootCacheKey]*FieldCache // Types analyzed with root rules, which may change the analysis through a dive
+	cacheLock *sync.RWMutex
 	rootLock  *sync.Mutex
 	typeLocks map[reflect.Type]*sync.Mutex
+
+	implicitTypeRules bool // Derives type rules from the Go types of the fields, see WithImplicitTypeRules
 }
 
-func newStructCache() *StructCache {
-	return &StructCache{Cache: map[reflect.Type]*FieldCache{}, rootLock: new(sync.Mutex)}
+type rootCacheKey struct {
+	targetType reflect.Type
+	rootRules  string
+}
+
+func newStructCache(implicitTypeRules bool) *StructCache {
+	return &StructCache{
+		Cache:     map[reflect.Type]*FieldCache{},
+		rootCache: map[rootCacheKey]*FieldCache{},
+		cacheLock: new(sync.RWMutex),
+		rootLock:  new(sync.Mutex),
+		typeLocks: map[reflect.Type]*sync.Mutex{},
+
+		implicitTypeRules: implicitTypeRules,
+	}
 }
 
 func (fieldCache *FieldCache) GetChildByName(name string) *FieldCache {
@@ -54,64 +163,117 @@ func (fieldCache *FieldCache) GetChildByName(name string) *FieldCache {
 	return nil
 }
 
+func (fieldCache *FieldCache) GetChildByJsonKey(key string) *FieldCache {
+	for _, child := range fieldCache.Children.All() {
+		if child.JsonKey == key {
+			return child
+		}
+	}
+
+	return nil
+}
+
 func (structCache *StructCache) Analyze(rulebook *Rulebook, targetType reflect.Type) (*FieldCache, error) {
-	// Unwrap pointer types, since we only focus on the underlying struct type
+	return structCache.AnalyzeWithRootRules(rulebook, targetType, "")
+}
+
+// AnalyzeWithRootRules analyzes the type like Analyze, but with validation rules for the top-level json value itself.
+// Invalid validation tags are returned as an error wrapping ErrInvalidSchema.
+func (structCache *StructCache) AnalyzeWithRootRules(rulebook *Rulebook, targetType reflect.Type, rootRules string) (_ *FieldCache, err error) {
+	defer recoverInvalidSchema(&err)
+
+	if targetType == nil {
+		return nil, fmt.Errorf("%w: the struct cache cannot Analyze nil", ErrUnsupportedTarget)
+	}
+
+	// Unwrap pointer types, since we only focus on the underlying type
 	targetType = structCache.typeIndirect(targetType)
 
 	// If the type has already been analyzed, then fetch from the cache
 	// This is the code path for 99.9999% of requests.
-	if cache, present := structCache.Cache[targetType]; present {
+	if cache, present := structCache.getCached(targetType, rootRules); present {
 		return cache, nil
 	}
 
-	// Otherwise, it is the first time we see this struct, and therefor has to perform the actual analysis
+	// Otherwise, it is the first time we see this type, and therefor has to perform the actual analysis
 	lock := structCache.acquireTypeLock(targetType)
 	defer lock.Unlock()
 
 	// There might have been another concurrent analyze call to the struct cache for the same time,
 	// while we were waiting for the type lock. In this case we can skip the additional analysis and simply use the cache
-	if cache, present := structCache.Cache[targetType]; present {
+	if cache, present := structCache.getCached(targetType, rootRules); present {
 		return cache, nil
 	}
 
-	// We only allow analyzing structs as root data types
-	if targetType.Kind() != reflect.Struct {
-		return nil, errors.New(fmt.Sprintf("the struct cache can only Analyze struct types %s given", targetType.Kind().String()))
+	// Any type which json can be unmarshalled into is allowed as the root data type
+	if !structCache.typeIsSupportedRoot(targetType) {
+		return nil, fmt.Errorf("%w: the struct cache cannot Analyze %s types", ErrUnsupportedTarget, targetType.Kind().String())
+	}
+
+	rootTag := newValidationTag(rulebook, rootRules)
+
+	if structCache.implicitTypeRules {
+		rootTag = structCache.withImplicitTypeRules(rootTag, targetType, false, rulebook)
 	}
 
 	root := &FieldCache{
-		Parent:     nil,
-		Children:   &Children{list: []*FieldCache{}},
-		Reflection: targetType,
-		JsonKey:    "",
-		StructKey:  "",
-		ValidationTag: &ValidationTag{
-			Rules:              []*RuleContext{},
-			PresenceRules:      []*RuleContext{},
-			ExplicitlyNullable: false,
-		},
-		IsStruct: true,
-		IsSlice:  false,
-		IsMap:    false,
+		Parent:        nil,
+		Children:      &Children{list: []*FieldCache{}},
+		Reflection:    targetType,
+		JsonKey:       "",
+		StructKey:     "",
+		ValidationTag: rootTag,
+		IsStruct:      structCache.typeIsStruct(targetType),
+		IsSlice:       structCache.typeIsSlice(targetType),
+		IsMap:         structCache.typeIsMap(targetType),
+		IsStrict:      structCache.isStrict(rootTag, targetType, ""),
 	}
 
 	structCache.traverseType(root, rulebook, intermediateCache{})
-	structCache.Cache[targetType] = root
+	structCache.setCached(targetType, rootRules, root)
 
 	return root, nil
 }
 
+func (structCache *StructCache) getCached(targetType reflect.Type, rootRules string) (*FieldCache, bool) {
+	structCache.cacheLock.RLock()
+	defer structCache.cacheLock.RUnlock()
+
+	if rootRules == "" {
+		cache, present := structCache.Cache[targetType]
+		return cache, present
+	}
+
+	cache, present := structCache.rootCache[rootCacheKey{targetType: targetType, rootRules: rootRules}]
+
+	return cache, present
+}
+
+func (structCache *StructCache) setCached(targetType reflect.Type, rootRules string, fieldCache *FieldCache) {
+	structCache.cacheLock.Lock()
+	defer structCache.cacheLock.Unlock()
+
+	if rootRules == "" {
+		structCache.Cache[targetType] = fieldCache
+	} else {
+		structCache.rootCache[rootCacheKey{targetType: targetType, rootRules: rootRules}] = fieldCache
+	}
+}
+
 func (structCache *StructCache) acquireTypeLock(targetType reflect.Type) *sync.Mutex {
 	// We first need the root lock, so we can get or create the required type lock without conflicts
 	structCache.rootLock.Lock()
-	defer structCache.rootLock.Unlock()
+	typeLock, present := structCache.typeLocks[targetType]
 
-	if typeLock, present := structCache.typeLocks[targetType]; present {
-		typeLock.Lock()
-		return typeLock
+	if !present {
+		typeLock = new(sync.Mutex)
+		structCache.typeLocks[targetType] = typeLock
 	}
 
-	typeLock := new(sync.Mutex)
+	structCache.rootLock.Unlock()
+
+	// The type lock must be acquired without holding the root lock.
+	// Otherwise, a slow analysis of one type would block the analysis of all other types.
 	typeLock.Lock()
 
 	return typeLock
@@ -126,6 +288,8 @@ func (structCache *StructCache) typeIndirect(targetType reflect.Type) reflect.Ty
 }
 
 func (structCache *StructCache) traverseType(parent *FieldCache, rulebook *Rulebook, cache intermediateCache) {
+	structCache.validateCollectionRules(parent)
+
 	if parent.IsStruct {
 		structCache.traverseStruct(parent, rulebook, cache)
 	} else if parent.IsSlice {
@@ -135,47 +299,91 @@ func (structCache *StructCache) traverseType(parent *FieldCache, rulebook *Ruleb
 	}
 }
 
+// validateCollectionRules rejects entry rules for fields without entries, and key rules for fields without keys.
+// Such rules would otherwise never run, so the field would silently be validated less than its tag tells.
+func (structCache *StructCache) validateCollectionRules(field *FieldCache) {
+	if field.ValidationTag.Dive != nil && !field.IsSlice && !field.IsMap {
+		panic(invalidSchemaError("Invalid [%s] for field [%s] of type [%s] - Only slices, arrays and maps have entries", diveRule, field.StructKey, field.Reflection))
+	}
+
+	if field.ValidationTag.Keys != nil && !field.IsMap {
+		panic(invalidSchemaError("Invalid [%s] for field [%s] of type [%s] - Only maps have keys", keysRule, field.StructKey, field.Reflection))
+	}
+}
+
 func (structCache *StructCache) traverseStruct(parent *FieldCache, rulebook *Rulebook, cache intermediateCache) {
 	numFields := parent.Reflection.NumField()
 
 	for i := 0; i < numFields; i++ {
 		structField := parent.Reflection.Field(i)
 		structType := structCache.typeIndirect(structField.Type)
+		validationTag := structCache.getValidationTag(structField, rulebook)
+		jsonTag := structCache.getJsonTagForStructField(structField)
+
+		if structCache.implicitTypeRules && structCache.hasImplicitTypeRules(structField, jsonTag) {
+			validationTag = structCache.withImplicitTypeRules(validationTag, structField.Type, structCache.isQuotedJsonField(structField), rulebook)
+		}
 
 		field := &FieldCache{
 			Parent:        parent,
 			Children:      &Children{list: []*FieldCache{}},
 			Reflection:    structType,
-			JsonKey:       structCache.getJsonTagForStructField(structField).JsonKey,
+			JsonKey:       jsonTag.JsonKey,
 			StructKey:     structField.Name,
-			ValidationTag: structCache.getValidationTag(structField, rulebook),
+			ValidationTag: validationTag,
 			IsStruct:      structCache.typeIsStruct(structType),
 			IsSlice:       structCache.typeIsSlice(structType),
 			IsMap:         structCache.typeIsMap(structType),
+			IsStrict:      structCache.isStrict(validationTag, structType, structField.Name),
+			Messages:      structCache.getValidationMessages(structField),
+			index:         structField.Index,
+			decodable:     structCache.isUnmarshalledField(structField),
+			tagged:        jsonTag.Tagged,
 		}
 
-		if cachedField, cached := cache[structType]; cached {
-			field.Children = cachedField.Children
-		} else {
-			cache[structType] = field
-			structCache.traverseType(field, rulebook, cache)
-		}
-
-		structCache.appendChild(parent, structField, field)
+		structCache.traverseCachedType(field, rulebook, cache)
+		structCache.appendChild(parent, structField, field, jsonTag)
 	}
+
+	parent.Children.indexJsonKeys()
 }
 
-func (structCache *StructCache) appendChild(parent *FieldCache, structField reflect.StructField, field *FieldCache) {
-	if structField.Anonymous { // Embedded fields are a part of the parent type itself
-		parent.Children.Append(field.Children.All()...)
+// traverseCachedType reuses the children of an already traversed field of the same type.
+// Fields declaring entry or key rules through a dive own children which depend on the tags, and are therefor never shared.
+func (structCache *StructCache) traverseCachedType(field *FieldCache, rulebook *Rulebook, cache intermediateCache) {
+	if field.ValidationTag.Dive != nil || field.ValidationTag.Keys != nil {
+		structCache.traverseType(field, rulebook, cache)
+		return
+	}
+
+	if cachedField, cached := cache[field.Reflection]; cached {
+		field.Children = cachedField.Children
+		field.Key = cachedField.Key
 	} else {
+		cache[field.Reflection] = field
+		structCache.traverseType(field, rulebook, cache)
+	}
+}
+
+func (structCache *StructCache) appendChild(parent *FieldCache, structField reflect.StructField, field *FieldCache, jsonTag *JsonTag) {
+	// Embedded structs are a part of the parent type itself, unless json treats them as a regular field through a json key in the tag
+	if !structField.Anonymous || !field.IsStruct || jsonTag.Tagged {
 		parent.Children.Append(field)
+		return
+	}
+
+	// The promoted fields are copies, since the children of the embedded type may be shared with other fields of the same type
+	for _, child := range field.Children.All() {
+		promoted := *child
+		promoted.index = append([]int{structField.Index[0]}, child.index...)
+		parent.Children.Append(&promoted)
 	}
 }
 
 func (structCache *StructCache) traverseMap(parent *FieldCache, rulebook *Rulebook, cache intermediateCache) {
 	sliceElem := structCache.typeIndirect(parent.Reflection)
 	mapSubType := structCache.typeIndirect(sliceElem.Elem())
+	entryTag := structCache.getEntryValidationTag(parent, rulebook, sliceElem.Elem())
 
 	field := &FieldCache{
 		Parent:        parent,
@@ -183,20 +391,86 @@ func (structCache *StructCache) traverseMap(parent *FieldCache, rulebook *Rulebo
 		Reflection:    mapSubType,
 		JsonKey:       "{index}",
 		StructKey:     "{index}",
-		ValidationTag: newValidationTag(rulebook, ""),
+		ValidationTag: entryTag,
 		IsStruct:      structCache.typeIsStruct(mapSubType),
 		IsSlice:       structCache.typeIsSlice(mapSubType),
 		IsMap:         structCache.typeIsMap(mapSubType),
+		IsStrict:      structCache.isStrict(entryTag, mapSubType, "{index}"),
 	}
 
-	if cachedField, cached := cache[mapSubType]; cached {
-		field.Children = cachedField.Children
-	} else {
-		cache[mapSubType] = field
-		structCache.traverseType(field, rulebook, cache)
+	parent.Key = structCache.buildMapKey(parent, rulebook)
+	structCache.traverseCachedType(field, rulebook, cache)
+	parent.Children.Append(field)
+}
+
+func (structCache *StructCache) buildMapKey(parent *FieldCache, rulebook *Rulebook) *FieldCache {
+	keyType := structCache.typeIndirect(parent.Reflection).Key()
+	keyTag := newValidationTag(rulebook, "")
+
+	if parent.ValidationTag.Keys != nil {
+		keyTag = parent.ValidationTag.Keys
 	}
 
-	parent.Children.Append(field)
+	// Keys which are not plain strings must be convertible into the key type of the map, before any other key rules apply.
+	// Otherwise, the key would only be rejected afterward, when the json is unmarshalled into the map.
+	if structCache.mapKeyNeedsConversion(keyType) {
+		converted := *keyTag
+		converted.Rules = append([]*RuleContext{rulebook.GetRule("mapKey")}, keyTag.Rules...)
+		keyTag = &converted
+	}
+
+	key := &FieldCache{
+		Parent:        parent,
+		Children:      &Children{list: []*FieldCache{}},
+		Reflection:    keyType,
+		JsonKey:       "{key}",
+		StructKey:     "{key}",
+		ValidationTag: keyTag,
+		IsStruct:      false,
+		IsSlice:       false,
+		IsMap:         false,
+	}
+
+	// Keys are never traversed, so entry and key rules within the key rules are rejected here
+	structCache.validateCollectionRules(key)
+
+	return key
+}
+
+func (structCache *StructCache) mapKeyNeedsConversion(keyType reflect.Type) bool {
+	return keyType.Kind() != reflect.String || reflect.PointerTo(keyType).Implements(textUnmarshalerType)
+}
+
+func (structCache *StructCache) typeIsSupportedRoot(reflectType reflect.Type) bool {
+	switch reflectType.Kind() {
+	case reflect.Struct, reflect.Slice, reflect.Array, reflect.Map, reflect.Interface,
+		reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
+
+// isStrict reports whether unknown keys must be rejected for the field, either through the strict rule or by embedding Strict in its struct type.
+// The strict rule on any other type than a struct is an invalid schema, since only the json object of a struct has known keys.
+func (structCache *StructCache) isStrict(validationTag *ValidationTag, reflectType reflect.Type, fieldName string) bool {
+	if !structCache.typeIsStruct(reflectType) {
+		if validationTag.Strict {
+			panic(invalidSchemaError("Invalid [%s] for field [%s] of type [%s] - Only structs have known keys", strictRule, fieldName, reflectType))
+		}
+
+		return false
+	}
+
+	if validationTag.Strict {
+		return true
+	}
+
+	marker, found := reflectType.FieldByName(strictType.Name())
+
+	return found && marker.Anonymous && marker.Type == strictType
 }
 
 func (structCache *StructCache) typeIsStruct(reflectType reflect.Type) bool {
@@ -217,12 +491,14 @@ func (structCache *StructCache) typeIsMap(reflectType reflect.Type) bool {
 
 func (structCache *StructCache) getJsonTagForStructField(field reflect.StructField) *JsonTag {
 	tagline, ok := field.Tag.Lookup("json")
+	jsonKey := strings.Split(tagline, ",")[0]
 
-	if !ok {
+	// Like json, a tag with only options such as ",omitempty" keeps the name of the field
+	if !ok || jsonKey == "" {
 		return &JsonTag{JsonKey: field.Name}
 	}
 
-	return &JsonTag{JsonKey: strings.Split(tagline, ",")[0]}
+	return &JsonTag{JsonKey: jsonKey, Tagged: true}
 }
 
 func (structCache *StructCache) getValidationTag(field reflect.StructField, rulebook *Rulebook) *ValidationTag {
@@ -235,9 +511,49 @@ func (structCache *StructCache) getValidationTag(field reflect.StructField, rule
 	return newValidationTag(rulebook, tagline)
 }
 
+// getValidationMessages parses the validationMessage tag, which maps rules to messages separated by pipes, like the validation tag.
+// The rules are given like the keys of a MessageCatalog, e.g. `validationMessage:"required=Is needed|lenBetween:1,64=Must be 1 to 64 characters"`.
+func (structCache *StructCache) getValidationMessages(field reflect.StructField) MessageCatalog {
+	tagline, ok := field.Tag.Lookup("validationMessage")
+
+	if !ok {
+		return nil
+	}
+
+	messages := MessageCatalog{}
+
+	for _, definition := range strings.Split(tagline, "|") {
+		rule, message, found := strings.Cut(definition, "=")
+		rule = strings.TrimSpace(rule)
+
+		if !found || rule == "" {
+			panic(invalidSchemaError("Invalid validationMessage [%s] of field [%s] - Expected the form rule=message", definition, field.Name))
+		}
+
+		messages[rule] = message
+	}
+
+	return messages
+}
+
+func (structCache *StructCache) getEntryValidationTag(parent *FieldCache, rulebook *Rulebook, entryType reflect.Type) *ValidationTag {
+	entryTag := parent.ValidationTag.Dive
+
+	if entryTag == nil {
+		entryTag = newValidationTag(rulebook, "")
+	}
+
+	if structCache.implicitTypeRules {
+		return structCache.withImplicitTypeRules(entryTag, entryType, false, rulebook)
+	}
+
+	return entryTag
+}
+
 func (structCache *StructCache) traverseSlice(parent *FieldCache, rulebook *Rulebook, cache intermediateCache) {
 	sliceElem := structCache.typeIndirect(parent.Reflection)
 	sliceSubtype := structCache.typeIndirect(sliceElem.Elem())
+	entryTag := structCache.getEntryValidationTag(parent, rulebook, sliceElem.Elem())
 
 	field := &FieldCache{
 		Parent:        parent,
@@ -245,18 +561,42 @@ func (structCache *StructCache) traverseSlice(parent *FieldCache, rulebook *Rule
 		Reflection:    sliceSubtype,
 		JsonKey:       "{index}",
 		StructKey:     "{index}",
-		ValidationTag: newValidationTag(rulebook, ""),
+		ValidationTag: entryTag,
 		IsStruct:      structCache.typeIsStruct(sliceSubtype),
 		IsSlice:       structCache.typeIsSlice(sliceSubtype),
 		IsMap:         structCache.typeIsMap(sliceSubtype),
+		IsStrict:      structCache.isStrict(entryTag, sliceSubtype, "{index}"),
 	}
 
-	if cachedField, cached := cache[sliceSubtype]; cached {
-		field.Children = cachedField.Children
-	} else {
-		cache[sliceSubtype] = field
-		structCache.traverseType(field, rulebook, cache)
+	structCache.traverseCachedType(field, rulebook, cache)
+	parent.Children.Append(field)
+}
+
+// isUnmarshalledField reports whether json.Unmarshal sets the field, meaning it is exported and not ignored by a "-" json tag.
+func (structCache *StructCache) isUnmarshalledField(field reflect.StructField) bool {
+	return field.IsExported() && field.Tag.Get("json") != "-"
+}
+
+// isQuotedJsonField reports whether the field uses the ",string" option, which only applies to strings, numbers and booleans.
+func (structCache *StructCache) isQuotedJsonField(field reflect.StructField) bool {
+	tagline, ok := field.Tag.Lookup("json")
+
+	if !ok {
+		return false
 	}
 
-	parent.Children.Append(field)
+	_, tagOptions, _ := strings.Cut(tagline, ",")
+
+	if !slices.Contains(strings.Split(tagOptions, ","), "string") {
+		return false
+	}
+
+	switch structCache.typeIndirect(field.Type).Kind() {
+	case reflect.String, reflect.Bool, reflect.Float32, reflect.Float64,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return true
+	default:
+		return false
+	}
 }
@@ -0,0 +1,228 @@
+package JsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// ErrEmptyJson is wrapped by the SyntaxError of input without any json value, e.g. an empty request body.
+var ErrEmptyJson = errors.New("json body is empty")
+
+// ErrTrailingData is wrapped by the SyntaxError of input with more than whitespace after the top-level json value.
+var ErrTrailingData = errors.New("unexpected data after the top-level json value")
+
+// The number of bytes on each side of the invalid byte, which are included in the excerpt of a SyntaxError
+const syntaxExcerptRadius = 20
+
+// SyntaxError is returned when the json cannot be parsed, and tells where in the input the json is invalid.
+// It wraps the original error, which is either a *json.SyntaxError, io.ErrUnexpectedEOF, ErrEmptyJson or ErrTrailingData.
+type SyntaxError struct {
+	Offset  int64  // The number of bytes read before the error, including the invalid byte
+	Line    int    // The line of the invalid byte, starting from 1
+	Column  int    // The column of the invalid byte counted in bytes, starting from 1
+	Excerpt string // The input surrounding the invalid byte
+	Err     error  // The original error
+
+	redacted bool // True if the input is left out, since the target has sensitive fields
+}
+
+// quotedCharacter matches the invalid byte quoted by the message of a json.SyntaxError, e.g. 'x' of "invalid character 'x' after object key".
+var quotedCharacter = regexp.MustCompile(`'(\\'|[^'])*' ?`)
+
+func (err *SyntaxError) Error() string {
+	description := err.Err.Error()
+
+	if location := quotedCharacter.FindStringIndex(description); err.redacted && location != nil {
+		description = description[:location[0]] + description[location[1]:]
+	}
+
+	return fmt.Sprintf("invalid json cannot be parsed: %s at line %d, column %d", description, err.Line, err.Column)
+}
+
+func (err *SyntaxError) Unwrap() error {
+	return err.Err
+}
+
+// Is makes every SyntaxError match ErrInvalidJSON.
+func (err *SyntaxError) Is(target error) bool {
+	return target == ErrInvalidJSON
+}
+
+// inputWindow keeps the part of the input, which errors may still point into, along with the line it starts on.
+// All of the input is kept when validating bytes, while a reader only keeps the input of the json value being decoded.
+type inputWindow struct {
+	input     []byte
+	start     int64 // The offset of the first kept byte
+	line      int   // The line of the first kept byte, starting from 1
+	lineStart int64 // The offset of the first byte of that line
+	base      int64 // The offset at which the decoder started reading, since the offsets of a decoder are relative to it
+}
+
+func newInputWindow(input []byte) *inputWindow {
+	return &inputWindow{input: input, line: 1}
+}
+
+// decoderOffset returns the offset of the decoder within the input.
+func (window *inputWindow) decoderOffset(decoder *json.Decoder) int64 {
+	return window.base + decoder.InputOffset()
+}
+
+func (window *inputWindow) end() int64 {
+	return window.start + int64(len(window.input))
+}
+
+// discardBefore drops the input before the offset, while keeping track of the line numbers of the remaining input.
+func (window *inputWindow) discardBefore(offset int64) {
+	if offset <= window.start {
+		return
+	}
+
+	discarded := window.input[:min(offset-window.start, int64(len(window.input)))]
+
+	if lines := bytes.Count(discarded, []byte{'\n'}); lines > 0 {
+		window.line += lines
+		window.lineStart = window.start + int64(bytes.LastIndexByte(discarded, '\n')) + 1
+	}
+
+	window.start += int64(len(discarded))
+	window.input = window.input[len(discarded):]
+}
+
+// convertDecodeError converts an error of decoding json from the input into a SyntaxError.
+// Errors of reading the input are kept as they are.
+func (window *inputWindow) convertDecodeError(err error) error {
+	var syntaxError *json.SyntaxError
+
+	switch {
+	case errors.As(err, &syntaxError):
+		return window.syntaxError(window.base+syntaxError.Offset, err)
+	case err == io.EOF:
+		return window.syntaxError(window.end(), ErrEmptyJson)
+	case err == io.ErrUnexpectedEOF:
+		return window.syntaxError(window.end(), err)
+	default:
+		return err
+	}
+}
+
+// convertContinuationDecodeError converts an error of decoding the members of an object or the entries of an array one at a time into a SyntaxError.
+// The offsets of errors from a decoder reading tokens are unreliable, so the input following the last decoded value,
+// which ends at the resume offset, is parsed again following the prefix, which stands in for the json preceding it.
+func (window *inputWindow) convertContinuationDecodeError(err error, resumeOffset int64, prefix string) error {
+	var syntaxError *json.SyntaxError
+
+	if !errors.As(err, &syntaxError) && err != io.ErrUnexpectedEOF {
+		return window.convertDecodeError(err)
+	}
+
+	var continuation any
+	reparseErr := json.NewDecoder(bytes.NewReader(append([]byte(prefix), window.input[resumeOffset-window.start:]...))).Decode(&continuation)
+
+	switch {
+	case errors.As(reparseErr, &syntaxError):
+		return window.syntaxError(syntaxError.Offset-int64(len(prefix))+resumeOffset, err)
+	case reparseErr == io.ErrUnexpectedEOF:
+		return window.syntaxError(window.end(), reparseErr)
+	default:
+		return window.convertDecodeError(err)
+	}
+}
+
+// continuationPrefix returns the prefix for parsing the input following a decoded part of an object or array, which is opened after the enclosing prefix.
+// The decoded members or entries are replaced by a single null, which cannot be continued by any input, unlike a number.
+func continuationPrefix(enclosing string, opening byte, hasEntries bool) string {
+	switch {
+	case !hasEntries:
+		return enclosing + string(opening)
+	case opening == '[':
+		return enclosing + "[null"
+	default:
+		return enclosing + `{"":null`
+	}
+}
+
+// trailingDataError returns the error of anything but whitespace following the top-level json value, which ends at the offset.
+func (window *inputWindow) trailingDataError(offset int64) *SyntaxError {
+	for offset < window.end() && isJsonWhitespace(window.input[offset-window.start]) {
+		offset++
+	}
+
+	return window.syntaxError(offset+1, ErrTrailingData)
+}
+
+func (window *inputWindow) syntaxError(offset int64, err error) *SyntaxError {
+	syntaxError := &SyntaxError{Offset: offset, Err: err}
+	position := min(max(offset-1, window.start), window.end())
+
+	// The invalid byte has already been discarded, so its position is unknown
+	if offset-1 < window.start && offset > 0 {
+		return syntaxError
+	}
+
+	sourcePosition := window.sourcePosition(position)
+	syntaxError.Line = sourcePosition.Line
+	syntaxError.Column = sourcePosition.Column
+
+	excerptStart := max(position-window.start-syntaxExcerptRadius, 0)
+	excerptEnd := min(position-window.start+syntaxExcerptRadius, int64(len(window.input)))
+	syntaxError.Excerpt = strings.ToValidUTF8(string(window.input[excerptStart:excerptEnd]), "")
+
+	return syntaxError
+}
+
+// sourcePosition returns the position of the byte at the offset, which must not have been discarded.
+func (window *inputWindow) sourcePosition(offset int64) SourcePosition {
+	preceding := window.input[:offset-window.start]
+	position := SourcePosition{
+		Offset: offset,
+		Line:   window.line + bytes.Count(preceding, []byte{'\n'}),
+		Column: int(offset-window.lineStart) + 1,
+	}
+
+	if lastNewline := bytes.LastIndexByte(preceding, '\n'); lastNewline >= 0 {
+		position.Column = len(preceding) - lastNewline
+	}
+
+	return position
+}
+
+// trackingReader keeps the input read from the reader, so errors of decoding the json can tell where the json is invalid.
+type trackingReader struct {
+	reader io.Reader
+	*inputWindow
+}
+
+func newTrackingReader(reader io.Reader) *trackingReader {
+	return &trackingReader{reader: reader, inputWindow: newInputWindow(nil)}
+}
+
+func (tracking *trackingReader) Read(buffer []byte) (int, error) {
+	read, err := tracking.reader.Read(buffer)
+	tracking.input = append(tracking.input, buffer[:read]...)
+
+	return read, err
+}
+
+func isJsonWhitespace(character byte) bool {
+	return character == ' ' || character == '\t' || character == '\r' || character == '\n'
+}
+
+// redactSyntaxError leaves the input out of a SyntaxError, when the target has any sensitive field.
+// The input surrounding the invalid byte cannot be tied to a field, so it may be part of a sensitive value.
+func redactSyntaxError(err error, fieldCache *FieldCache) error {
+	syntaxError, isSyntaxError := err.(*SyntaxError)
+
+	if !isSyntaxError || fieldCache == nil || !fieldCache.hasSensitiveField() {
+		return err
+	}
+
+	syntaxError.Excerpt = ""
+	syntaxError.redacted = true
+
+	return syntaxError
+}
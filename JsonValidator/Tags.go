@@ -6,14 +6,33 @@ import (
 
 type JsonTag struct {
 	JsonKey string
+	Tagged  bool // True if the json key is given by the tag, instead of being the name of the field
 }
 
 type ValidationTag struct {
 	Rules              []*RuleContext
 	PresenceRules      []*RuleContext
 	ExplicitlyNullable bool
+	Strict             bool           // True if unknown keys in the json object of the field must be rejected
+	Bail               bool           // True if the remaining rules of the field must be skipped after the first failed rule
+	Sensitive          bool           // True if the value of the field must be redacted in errors, see RedactionPolicy
+	Dive               *ValidationTag // The rules for each entry of a slice, array or map. Nil when no dive is declared
+	Keys               *ValidationTag // The rules for each key of a map. Nil when no keys are declared
 }
 
+// diveRule separates the rules of a field from the rules of its entries.
+// Everything after the first dive applies to each entry, so nested collections may use multiple dives.
+// The rules for the keys of a map are placed directly after the dive between keysRule and endKeysRule.
+// strictRule, bailRule and sensitiveRule are flags rather than rules, since they change how the field itself is validated.
+const (
+	diveRule      = "dive"
+	keysRule      = "keys"
+	endKeysRule   = "endkeys"
+	strictRule    = "strict"
+	bailRule      = "bail"
+	sensitiveRule = "sensitive"
+)
+
 func newValidationTag(rulebook *Rulebook, tagline string) *ValidationTag {
 	if tagline == "" {
 		return &ValidationTag{
@@ -23,17 +42,52 @@ func newValidationTag(rulebook *Rulebook, tagline string) *ValidationTag {
 		}
 	}
 
+	ruleParser := func(definition string) []string {
+		return strings.Split(strings.TrimSpace(definition), "|")
+	}
+
+	return parseRuleDefinitions(rulebook, unwrapCompositeRules(rulebook, ruleParser(tagline), ruleParser))
+}
+
+func parseRuleDefinitions(rulebook *Rulebook, ruleDefinitions []string) *ValidationTag {
 	var rules []*RuleContext
 	var presenceRules []*RuleContext
+	var dive *ValidationTag
+	var keys *ValidationTag
 	explicitNullable := false
+	strict := false
+	bail := false
+	sensitive := false
+
+	for i, ruleDefinition := range ruleDefinitions {
+		if ruleDefinition == strictRule {
+			strict = true
+			continue
+		}
 
-	ruleParser := func(definition string) []string {
-		return strings.Split(strings.TrimSpace(definition), "|")
-	}
+		if ruleDefinition == bailRule {
+			bail = true
+			continue
+		}
 
-	ruleDefinitions := unwrapCompositeRules(rulebook, ruleParser(tagline), ruleParser)
+		if ruleDefinition == sensitiveRule {
+			sensitive = true
+			continue
+		}
+
+		if ruleDefinition == diveRule {
+			entryDefinitions := ruleDefinitions[i+1:]
+
+			if len(entryDefinitions) > 0 && entryDefinitions[0] == keysRule {
+				keyDefinitions, remainingDefinitions := splitKeyRuleDefinitions(entryDefinitions[1:])
+				keys = parseRuleDefinitions(rulebook, keyDefinitions)
+				entryDefinitions = remainingDefinitions
+			}
+
+			dive = parseRuleDefinitions(rulebook, entryDefinitions)
+			break
+		}
 
-	for _, ruleDefinition := range ruleDefinitions {
 		rule := rulebook.GetRule(ruleDefinition)
 
 		if rule.IsPresenceRule {
@@ -51,7 +105,22 @@ func newValidationTag(rulebook *Rulebook, tagline string) *ValidationTag {
 		Rules:              rules,
 		PresenceRules:      presenceRules,
 		ExplicitlyNullable: explicitNullable,
+		Strict:             strict,
+		Bail:               bail,
+		Sensitive:          sensitive,
+		Dive:               dive,
+		Keys:               keys,
+	}
+}
+
+func splitKeyRuleDefinitions(ruleDefinitions []string) ([]string, []string) {
+	for i, ruleDefinition := range ruleDefinitions {
+		if ruleDefinition == endKeysRule {
+			return ruleDefinitions[:i], ruleDefinitions[i+1:]
+		}
 	}
+
+	panic(invalidSchemaError("Missing [%s] for the map key rules [%s]", endKeysRule, strings.Join(ruleDefinitions, "|")))
 }
 
 func unwrapCompositeRules(rulebook *Rulebook, ruleDefinitions []string, ruleParser func(tagLine string) []string) []string {
@@ -0,0 +1,126 @@
+package JsonValidator
+
+import (
+	"context"
+	"io"
+	"reflect"
+)
+
+// defaultValidator is shared by the package level functions.
+// Rules, aliases and composites registered on it are available to all of them.
+var defaultValidator = New()
+
+// Default returns the shared validator used by Validate, ValidateInto and ValidatorFor.
+// Custom rules should be registered on it during startup, before any validation takes place.
+func Default() *Validator {
+	return defaultValidator
+}
+
+// Validate validates the json against the rules of T using the default validator, and returns the unmarshalled value.
+// The returned value should only be used when no error is returned.
+func Validate[T any](jsonData []byte, options ...Option) (T, error) {
+	var target T
+	err := ValidateInto(jsonData, &target, options...)
+
+	return target, err
+}
+
+// ValidateInto validates the json against the rules of T using the default validator, and unmarshals it into target.
+func ValidateInto[T any](jsonData []byte, target *T, options ...Option) error {
+	return defaultValidator.Validate(jsonData, target, options...)
+}
+
+// ValidateContext validates like Validate, but stops the validation with the error of the context once it is cancelled.
+func ValidateContext[T any](ctx context.Context, jsonData []byte, options ...Option) (T, error) {
+	var target T
+	err := defaultValidator.ValidateContext(ctx, jsonData, &target, options...)
+
+	return target, err
+}
+
+// TypedValidator validates json against a single type, which is analyzed once when the TypedValidator is created.
+// It is safe for concurrent use.
+type TypedValidator[T any] struct {
+	validator  *Validator
+	fieldCache *FieldCache
+}
+
+// ValidatorFor returns a TypedValidator for T backed by the default validator.
+func ValidatorFor[T any]() (*TypedValidator[T], error) {
+	return NewTypedValidator[T](defaultValidator)
+}
+
+// NewTypedValidator returns a TypedValidator for T backed by the given validator.
+// Any error in the analysis of T is returned here, instead of on the first validation.
+func NewTypedValidator[T any](validator *Validator) (*TypedValidator[T], error) {
+	fieldCache, err := validator.analyze(reflect.TypeOf((*T)(nil)), &validator.options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &TypedValidator[T]{validator: validator, fieldCache: fieldCache}, nil
+}
+
+// Validate validates the json and returns the unmarshalled value.
+// The returned value should only be used when no error is returned.
+func (typed *TypedValidator[T]) Validate(jsonData []byte, options ...Option) (T, error) {
+	var target T
+	err := typed.ValidateInto(jsonData, &target, options...)
+
+	return target, err
+}
+
+// ValidateInto validates the json and unmarshals it into target.
+func (typed *TypedValidator[T]) ValidateInto(jsonData []byte, target *T, options ...Option) error {
+	return typed.validateInto(context.Background(), jsonData, target, options)
+}
+
+// ValidateContext validates like Validate, but stops the validation with the error of the context once it is cancelled.
+func (typed *TypedValidator[T]) ValidateContext(ctx context.Context, jsonData []byte, options ...Option) (T, error) {
+	var target T
+	err := typed.validateInto(ctx, jsonData, &target, options)
+
+	return target, err
+}
+
+func (typed *TypedValidator[T]) validateInto(ctx context.Context, jsonData []byte, target *T, options []Option) error {
+	jsonRaw, err := typed.validator.parseJson(jsonData)
+	resolvedOptions := typed.validator.resolveOptions(options)
+	fieldCache, analyzeErr := typed.getFieldCache(resolvedOptions)
+
+	if err != nil {
+		return redactSyntaxError(err, fieldCache)
+	}
+
+	if analyzeErr != nil {
+		return analyzeErr
+	}
+
+	return typed.validator.validateWithFieldCache(ctx, jsonData, jsonRaw, fieldCache, target, resolvedOptions)
+}
+
+// ValidateReader validates json read from the reader and returns the unmarshalled value.
+// The returned value should only be used when no error is returned.
+func (typed *TypedValidator[T]) ValidateReader(ctx context.Context, reader io.Reader, options ...Option) (T, error) {
+	var target T
+	resolvedOptions := typed.validator.resolveOptions(options)
+	fieldCache, err := typed.getFieldCache(resolvedOptions)
+
+	if err != nil {
+		return target, err
+	}
+
+	err = typed.validator.validateReaderWithFieldCache(ctx, reader, fieldCache, &target, resolvedOptions)
+
+	return target, err
+}
+
+// getFieldCache returns the pre-analyzed type, unless the options of the call changes the root rules.
+func (typed *TypedValidator[T]) getFieldCache(options *Options) (*FieldCache, error) {
+	if options.RootRules == typed.validator.options.RootRules {
+		return typed.fieldCache, nil
+	}
+
+	return typed.validator.analyze(reflect.TypeOf((*T)(nil)), options)
+}
@@ -0,0 +1,134 @@
+package JsonValidator
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+const typeRule = "type"
+
+// addUnmarshalError converts an error of populating the target into a type error of the json value it failed on.
+// A payload passing every validation rule, which still cannot be unmarshalled, is then reported like any other invalid payload.
+// The context is the one of the json value which was unmarshalled, and jsonRaw is its parsed value.
+// Returns false if the error cannot be tied to a value of the json, in which case it has to be returned as it is.
+func (validator *Validator) addUnmarshalError(err error, jsonData []byte, jsonRaw any, context *ValidationContext, validation *ErrorBag) bool {
+	var typeError *json.UnmarshalTypeError
+	var syntaxError *json.SyntaxError
+
+	switch {
+	case errors.As(err, &typeError):
+		validation.addFieldError(validator.buildTypeError(validation, jsonRaw, context, validator.getPathAtOffset(jsonData, typeError.Offset, typeError.Field), typeError.Type))
+	case errors.As(err, &syntaxError):
+		validation.addFieldError(validator.buildTypeError(validation, jsonRaw, context, validator.getPathAtOffset(jsonData, syntaxError.Offset, ""), nil))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// buildTypeError builds the error of the json value at the relative path, which cannot be unmarshalled into the target type.
+func (validator *Validator) buildTypeError(validation *ErrorBag, jsonRaw any, context *ValidationContext, relativePath []PathSegment, targetType reflect.Type) FieldError {
+	segments := append(context.pathSegments(), relativePath...)
+	fieldError := FieldError{
+		Path:     validation.formatPath(segments),
+		Segments: segments,
+		Rule:     typeRule,
+		Message:  "Cannot be unmarshalled",
+		Value:    lookupJsonValue(jsonRaw, relativePath),
+		Category: TypeCategory,
+	}
+
+	if targetType != nil {
+		fieldError.Params = []string{targetType.String()}
+		fieldError.Message = fmt.Sprintf("Must be a value convertible to [%s]", targetType)
+	}
+
+	if context.isSensitive() || context.Field.isSensitiveAt(relativePath) {
+		fieldError = redact(fieldError, context.RootContext.Options.redactionPolicy())
+	}
+
+	return validator.localize(validation.catalogs, fieldError, nil)
+}
+
+// lookupJsonValue returns the parsed json value at the path, or nil if there is no such value.
+func lookupJsonValue(jsonRaw any, path []PathSegment) any {
+	for _, segment := range path {
+		switch jsonValue := jsonRaw.(type) {
+		case map[string]any:
+			jsonRaw = jsonValue[segment.String()]
+		case []any:
+			if !segment.IsIndex() || segment.Index >= len(jsonValue) {
+				return nil
+			}
+
+			jsonRaw = jsonValue[segment.Index]
+		default:
+			return nil
+		}
+	}
+
+	return jsonRaw
+}
+
+// getPathAtOffset finds the path of the json value at the byte offset of an error from json.Unmarshal.
+// The offset of an error points to the end of the value it failed on, so the path is the one of the first token reaching the offset.
+// If the offset is outside the json, the path is derived from the dotted field of the error instead.
+func (validator *Validator) getPathAtOffset(jsonData []byte, offset int64, field string) []PathSegment {
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+
+	if offsetPath, found, _ := validator.findPathAtOffset(decoder, nil, offset); found {
+		return offsetPath
+	}
+
+	return parsePath(DottedPath, field)
+}
+
+func (validator *Validator) findPathAtOffset(decoder *json.Decoder, path []PathSegment, offset int64) ([]PathSegment, bool, error) {
+	token, err := decoder.Token()
+
+	if err != nil {
+		return nil, false, err
+	}
+
+	if decoder.InputOffset() >= offset {
+		return path, true, nil
+	}
+
+	delimiter, isDelimiter := token.(json.Delim)
+
+	if !isDelimiter {
+		return nil, false, nil
+	}
+
+	for index := 0; decoder.More(); index++ {
+		entryPath := appendSegment(path, IndexSegment(index))
+
+		if delimiter == '{' {
+			keyToken, err := decoder.Token()
+
+			if err != nil {
+				return nil, false, err
+			}
+
+			entryPath = appendSegment(path, KeySegment(keyToken.(string)))
+
+			// Errors of map keys point into the key itself
+			if decoder.InputOffset() >= offset {
+				return entryPath, true, nil
+			}
+		}
+
+		if entryPath, found, err := validator.findPathAtOffset(decoder, entryPath, offset); found || err != nil {
+			return entryPath, found, err
+		}
+	}
+
+	// Consumes the closing delimiter of the object or array
+	_, err = decoder.Token()
+
+	return nil, false, err
+}
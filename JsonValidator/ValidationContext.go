@@ -1,5 +1,10 @@
 package JsonValidator
 
+import (
+	"context"
+	"strconv"
+)
+
 type ValidationContext struct {
 	Json            *JsonContext
 	RootContext     *ValidationContext
@@ -9,6 +14,10 @@ type ValidationContext struct {
 	StructFieldName string
 	ValidationTag   *ValidationTag
 	Validator       *Validator
+	Options         *Options        // The options of the validation. Only set on the root context
+	Context         context.Context // The context of the validation. Only set on the root context
+
+	streamedArrays map[*FieldCache]*streamedArray // The arrays whose entries were validated while reading them, see ValidateReader. Only set on the root context
 }
 
 func (context *ValidationContext) GetNeighborField(name string) (*ValidationContext, bool) {
@@ -21,6 +30,41 @@ func (context *ValidationContext) GetNeighborField(name string) (*ValidationCont
 	return context.Validator.buildFieldContext(context.ParentContext, neighbor), true
 }
 
+// pathSegments returns the json path of the value under validation, from the root to the value.
+// The path is derived from the chain of parent contexts, so it is only built for values with errors.
+func (context *ValidationContext) pathSegments() []PathSegment {
+	parentContext := context.ParentContext
+
+	if parentContext == nil || parentContext == context {
+		return nil
+	}
+
+	if parentContext.Field.IsSlice {
+		index, _ := strconv.Atoi(context.FieldName)
+
+		return appendSegment(parentContext.pathSegments(), IndexSegment(index))
+	}
+
+	return appendSegment(parentContext.pathSegments(), KeySegment(context.FieldName))
+}
+
+// streamedArray returns the array of the value under validation, if its entries were validated while reading them.
+// Only the top-level value and the fields of a top-level struct are streamed.
+func (context *ValidationContext) streamedArray() *streamedArray {
+	if !context.IsRoot() && !context.ParentContext.IsRoot() {
+		return nil
+	}
+
+	return context.RootContext.streamedArrays[context.Field]
+}
+
 func (context *ValidationContext) IsRoot() bool {
-	return context.Json.Path == ""
+	return context.RootContext == context
+}
+
+// IsMapKey reports whether the value under validation is the key of a map entry, validated by the rules between keys and endkeys.
+func (context *ValidationContext) IsMapKey() bool {
+	parentContext := context.ParentContext
+
+	return parentContext != nil && parentContext != context && parentContext.Field.Key == context.Field
 }
@@ -1,23 +1,32 @@
 package JsonValidator
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"reflect"
+	"sort"
 	"strconv"
-	"strings"
 )
 
 type Validator struct {
 	*Rulebook
 	structCache *StructCache
+	options     Options
+	catalogs    map[string]MessageCatalog
 }
 
-func New() *Validator {
+func New(options ...Option) *Validator {
+	resolvedOptions := newOptions(options)
+
 	return &Validator{
-		Rulebook:    newRulebook(rules, nullableRules, presenceRules, aliases),
-		structCache: newStructCache(),
+		Rulebook:    newRulebook(rules, nullableRules, presenceRules, typeRules, aliases),
+		structCache: newStructCache(resolvedOptions.ImplicitTypeRules),
+		options:     resolvedOptions,
+		catalogs:    map[string]MessageCatalog{"en": EnglishMessages, "da": DanishMessages},
 	}
 }
 
@@ -29,53 +38,160 @@ type JsonContext struct {
 	Value      any    // The raw json parsed value for the key. Will be nil if KeyPresent=false
 }
 
-func (validator *Validator) Validate(jsonData []byte, dataTarget any) error {
-	var jsonRaw map[string]any
+func (validator *Validator) Validate(jsonData []byte, dataTarget any, options ...Option) error {
+	return validator.ValidateContext(context.Background(), jsonData, dataTarget, options...)
+}
+
+// ValidateContext validates like Validate, but stops the validation with the error of the context once it is cancelled.
+// The context is available to rules through FieldValidationContext.Context.
+func (validator *Validator) ValidateContext(ctx context.Context, jsonData []byte, dataTarget any, options ...Option) error {
+	jsonRaw, err := validator.parseJson(jsonData)
+	resolvedOptions := validator.resolveOptions(options)
+	fieldCache, analyzeErr := validator.analyze(reflect.TypeOf(dataTarget), resolvedOptions)
 
-	// This also verifies the integrity of the payload being valid json
-	if err := json.Unmarshal(jsonData, &jsonRaw); err != nil {
-		return errors.New("invalid json cannot be parsed")
+	if err != nil {
+		return redactSyntaxError(err, fieldCache)
 	}
 
-	fieldCache, err := validator.structCache.Analyze(validator.Rulebook, reflect.TypeOf(dataTarget))
+	if analyzeErr != nil {
+		return analyzeErr
+	}
 
-	if err != nil {
-		return err
+	return validator.validateWithFieldCache(ctx, jsonData, jsonRaw, fieldCache, dataTarget, resolvedOptions)
+}
+
+func (validator *Validator) parseJson(jsonData []byte) (any, error) {
+	var jsonRaw any
+
+	// Numbers are decoded as json.Number, so validation rules can compare them without loss of precision
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+
+	// This also verifies the integrity of the payload being valid json
+	if err := decoder.Decode(&jsonRaw); err != nil {
+		return nil, newInputWindow(jsonData).convertDecodeError(err)
 	}
 
-	validation := newErrorBag()
-	context := &ValidationContext{
-		Json: &JsonContext{
-			KeyPresent: true,
-			Value:      jsonRaw,
-		},
-		Field:     fieldCache,
-		Validator: validator,
+	// Just like json.Unmarshal, nothing but whitespace may follow the json value
+	valueEnd := decoder.InputOffset()
+
+	if _, err := decoder.Token(); err != io.EOF {
+		return nil, newInputWindow(jsonData).trailingDataError(valueEnd)
 	}
-	context.RootContext = context
-	context.ParentContext = context
+
+	return jsonRaw, nil
+}
+
+func (validator *Validator) validateWithFieldCache(ctx context.Context, jsonData []byte, jsonRaw any, fieldCache *FieldCache, dataTarget any, options *Options) (err error) {
+	// Some rules can only discover an invalid schema while validating, e.g. references to other fields
+	defer recoverInvalidSchema(&err)
+
+	validation := newErrorBag(options.MaxErrors, options.pathFormatter(), validator.getCatalogs(options))
+	context := validator.buildRootContext(ctx, fieldCache, jsonRaw, options)
 
 	// Runs the actual validation against the json
-	validator.validateStructSubFields(context, validation)
+	validator.validateRoot(context, validation)
+
+	// A cancelled validation is incomplete, so neither the found errors nor the unmarshalled data can be trusted
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if options.DuplicateKeys {
+		validator.validateDuplicateKeys(jsonData, nil, fieldCache, validation)
+	}
 
 	// Validation errors has priority over any unmarshal errors
 	// Since the json validation should also discover such errors by itself
 	if validation.IsInvalid() {
+		validator.addSourcePositionsIfEnabled(jsonData, validation.fieldErrors, 0, options)
+
 		return validation
 	}
 
 	// If there was no validation errors, but still unmarshal errors
 	// Then our validation rules do not fully cover our API,
-	// and we fall back to returning the unmarshal errors
-	if err := json.Unmarshal(jsonData, dataTarget); err != nil {
-		return err
+	// and we fall back to reporting the unmarshal errors as type errors
+	if err := validator.populateTarget(jsonData, dataTarget); err != nil {
+		if !validator.addUnmarshalError(err, jsonData, jsonRaw, context, validation) {
+			return err
+		}
+
+		validator.addSourcePositionsIfEnabled(jsonData, validation.fieldErrors, 0, options)
+
+		return validation
 	}
 
 	return nil
 }
 
+// populateTarget unmarshals the validated json into the dataTarget.
+func (validator *Validator) populateTarget(jsonData []byte, dataTarget any) error {
+	err := json.Unmarshal(jsonData, dataTarget)
+
+	var invalidUnmarshalError *json.InvalidUnmarshalError
+	var typeError *json.UnmarshalTypeError
+	var syntaxError *json.SyntaxError
+
+	switch {
+	case err == nil, errors.As(err, &typeError), errors.As(err, &syntaxError):
+		return err
+	case errors.As(err, &invalidUnmarshalError):
+		// Targets which are not a non-nil pointer can be validated against, but never unmarshalled into
+		return fmt.Errorf("%w: %w", ErrUnsupportedTarget, err)
+	default:
+		// json.Unmarshal returns the errors of custom unmarshalers as they are, without the path of the value they failed on.
+		// They are still caused by the json, so they are reported as failed validation instead of an unknown error.
+		return fmt.Errorf("%w: %w", ErrValidationFailed, err)
+	}
+}
+
+func (validator *Validator) buildRootContext(ctx context.Context, fieldCache *FieldCache, jsonRaw any, options *Options) *ValidationContext {
+	context := &ValidationContext{
+		Json:          validator.buildJsonContextForValue(options.pathFormatter().Root(), true, jsonRaw),
+		Field:         fieldCache,
+		ValidationTag: fieldCache.ValidationTag,
+		Validator:     validator,
+		Options:       options,
+		Context:       ctx,
+	}
+	context.RootContext = context
+	context.ParentContext = context
+
+	return context
+}
+
 func (validator *Validator) Analyze(dataTarget any) (*FieldCache, error) {
-	return validator.structCache.Analyze(validator.Rulebook, reflect.TypeOf(dataTarget))
+	return validator.analyze(reflect.TypeOf(dataTarget), &validator.options)
+}
+
+func (validator *Validator) analyze(targetType reflect.Type, options *Options) (*FieldCache, error) {
+	return validator.structCache.AnalyzeWithRootRules(validator.Rulebook, targetType, options.RootRules)
+}
+
+// validateRoot validates the top-level json value against the root rules, before traversing into it.
+// Unlike other fields, a null root is still traversed, so the fields of a struct report their own presence errors.
+func (validator *Validator) validateRoot(context *ValidationContext, validation *ErrorBag) {
+	if presenceErrors := validator.runRules(context, validation, context.ValidationTag.PresenceRules); presenceErrors {
+		return
+	}
+
+	if context.Json.IsNull && context.ValidationTag.ExplicitlyNullable {
+		return
+	}
+
+	if errorsFound := validator.runRules(context, validation, context.ValidationTag.Rules); errorsFound {
+		return
+	}
+
+	// A struct can only be unmarshalled from a json object, so any other json value is rejected before traversing into it.
+	if context.Field.IsStruct && !context.Json.IsNull {
+		if errorsFound := validator.runRules(context, validation, []*RuleContext{validator.GetRule("object")}); errorsFound {
+			return
+		}
+	}
+
+	validator.traverseField(context, validation)
 }
 
 // traverseField is responsible for continuing the traversal from a specific field.
@@ -94,6 +210,13 @@ func (validator *Validator) traverseField(context *ValidationContext, validation
 }
 
 func (validator *Validator) validateSliceEntries(context *ValidationContext, validation *ErrorBag) {
+	// The errors of entries validated while reading them are only reported now, since the rules of the array itself have passed
+	if array := context.streamedArray(); array != nil {
+		validation.Merge(array.validation)
+
+		return
+	}
+
 	jsonReflection := reflect.ValueOf(context.Json.Value)
 
 	// If the json value is not an array, then we cannot continue the traversal.
@@ -106,11 +229,10 @@ func (validator *Validator) validateSliceEntries(context *ValidationContext, val
 	jsonArrayLen := jsonReflection.Len()
 	sliceSubtype := context.Field.Children.All()[0]
 
-	// TODO: Support diving
-	for i := 0; i < jsonArrayLen; i++ {
-		// TODO: Support diving, so we can validate the entry itself, and not just the entry sub fields/entries
-		// This will validate the individual entries by ensuring any of its subfields has correct values.
-		validator.traverseField(validator.buildSliceEntryContext(context, sliceSubtype, i), validation)
+	// Each entry is validated as a field of its own, using the rules declared after a dive.
+	// This will also validate the individual entries by ensuring any of its subfields has correct values.
+	for i := 0; i < jsonArrayLen && !validator.isStopped(context, validation); i++ {
+		validator.validateField(validator.buildSliceEntryContext(context, sliceSubtype, i), validation)
 	}
 }
 
@@ -127,11 +249,20 @@ func (validator *Validator) validateMapEntries(context *ValidationContext, valid
 	mapKeys := jsonReflection.MapKeys()
 	sliceSubtype := context.Field.Children.All()[0]
 
-	// TODO: Support diving
+	// Sorted, so the errors are reported in the same order for every validation
+	sort.Slice(mapKeys, func(i, j int) bool {
+		return mapKeys[i].String() < mapKeys[j].String()
+	})
+
+	// Each key and entry is validated as a field of its own, using the rules declared after a dive.
+	// This will also validate the individual entries by ensuring any of its subfields has correct values.
 	for _, key := range mapKeys {
-		// TODO: Support diving, so we can validate the entry itself, and not just the entry sub fields/entries
-		// This will validate the individual entries by ensuring any of its subfields has correct values.
-		validator.traverseField(validator.buildMapEntryContext(context, sliceSubtype, key.String()), validation)
+		if validator.isStopped(context, validation) {
+			return
+		}
+
+		validator.validateField(validator.buildMapKeyContext(context, context.Field.Key, key.String()), validation)
+		validator.validateField(validator.buildMapEntryContext(context, sliceSubtype, key.String()), validation)
 	}
 }
 
@@ -154,14 +285,8 @@ func (validator *Validator) buildSliceEntryContext(parentContext *ValidationCont
 		Field:           fieldCache,
 		FieldName:       stringKey,
 		StructFieldName: stringKey,
-		// The Validation tag does not really matter, since we are never validation this exact context
-		// We are only using it as a parent context when validating an entry within a slice.
-		ValidationTag: &ValidationTag{
-			Rules:              []*RuleContext{},
-			ExplicitlyNullable: false,
-			PresenceRules:      []*RuleContext{},
-		},
-		Validator: parentContext.Validator,
+		ValidationTag:   fieldCache.ValidationTag,
+		Validator:       parentContext.Validator,
 	}
 }
 
@@ -173,23 +298,44 @@ func (validator *Validator) buildMapEntryContext(parentContext *ValidationContex
 		Field:           fieldCache,
 		FieldName:       key,
 		StructFieldName: key,
-		// The Validation tag does not really matter, since we are never validation this exact context
-		// We are only using it as a parent context when validating an entry within a slice.
-		ValidationTag: &ValidationTag{
-			Rules:              []*RuleContext{},
-			ExplicitlyNullable: false,
-			PresenceRules:      []*RuleContext{},
-		},
-		Validator: parentContext.Validator,
+		ValidationTag:   fieldCache.ValidationTag,
+		Validator:       parentContext.Validator,
+	}
+}
+
+func (validator *Validator) buildMapKeyContext(parentContext *ValidationContext, fieldCache *FieldCache, key string) *ValidationContext {
+	return &ValidationContext{
+		// The key itself is the value under validation, but errors are still reported on the path of the entry, marked by FieldError.IsKey.
+		Json:            validator.buildJsonContextForValue(validator.getPathForStringKey(parentContext, key), true, key),
+		RootContext:     parentContext.RootContext,
+		ParentContext:   parentContext,
+		Field:           fieldCache,
+		FieldName:       key,
+		StructFieldName: key,
+		ValidationTag:   fieldCache.ValidationTag,
+		Validator:       parentContext.Validator,
 	}
 }
 
 func (validator *Validator) validateStructSubFields(context *ValidationContext, validation *ErrorBag) {
 	for _, subField := range context.Field.Children.All() {
+		if validator.isStopped(context, validation) {
+			return
+		}
+
 		fieldContext := validator.buildFieldContext(context, subField)
 
 		validator.validateField(fieldContext, validation)
 	}
+
+	if context.Field.IsStrict || context.RootContext.Options.Strict {
+		validator.validateUnknownKeys(context, validation)
+	}
+}
+
+// isStopped reports whether the traversal must stop, since either the maximum number of errors is reached or the validation is cancelled.
+func (validator *Validator) isStopped(context *ValidationContext, validation *ErrorBag) bool {
+	return validation.IsFull() || context.RootContext.Context.Err() != nil
 }
 
 func (validator *Validator) validateField(context *ValidationContext, validation *ErrorBag) {
@@ -217,7 +363,9 @@ func (validator *Validator) validateField(context *ValidationContext, validation
 	// Then, run all non-presence rules.
 	errorsFound := validator.runRules(context, validation, context.ValidationTag.Rules)
 
-	if context.Json.KeyPresent && !context.Json.IsNull && !errorsFound {
+	// Null entries of slices and maps are still traversed, unless their dive rules allow null,
+	// so the fields of a struct entry report their own presence errors, like the fields of a null root.
+	if context.Json.KeyPresent && (!context.Json.IsNull || context.isEntry()) && !errorsFound {
 		validator.traverseField(context, validation)
 	}
 }
@@ -226,9 +374,27 @@ func (validator *Validator) runRules(context *ValidationContext, validation *Err
 	errorsFound := false
 
 	for _, rule := range rules {
+		// A bailing field stops at its first failed rule, and no rules are run once the maximum number of errors is reached
+		if (errorsFound && context.ValidationTag.Bail) || validation.IsFull() {
+			break
+		}
+
+		if errorsFound && rule.skipAfterFailure {
+			continue
+		}
+
 		if errorText, success := rule.Function(&FieldValidationContext{Validation: context, Params: rule.Params, RuleName: rule.Name}); !success {
 			errorsFound = true
-			validation.AddError(context.Json.Path, fmt.Sprintf("[%s]: %s", rule.Name, errorText))
+			validation.addFieldError(validator.localize(validation.catalogs, context.redactIfSensitive(FieldError{
+				Path:     context.Json.Path,
+				Segments: context.pathSegments(),
+				Rule:     rule.Name,
+				Params:   rule.Params,
+				Message:  errorText,
+				Value:    context.Json.Value,
+				Category: rule.category(),
+				IsKey:    context.IsMapKey(),
+			}), context))
 		}
 	}
 
@@ -248,8 +414,12 @@ func (validator *Validator) buildFieldContext(parentContext *ValidationContext,
 	}
 }
 
+func (validator *Validator) getPathForIntegerKey(parentContext *ValidationContext, key int) string {
+	return parentContext.RootContext.Options.pathFormatter().Append(parentContext.Json.Path, IndexSegment(key))
+}
+
 func (validator *Validator) getJsonContextForIntegerKey(parentContext *ValidationContext, key int) *JsonContext {
-	path := fmt.Sprintf("%s.%d", parentContext.Json.Path, key)
+	path := validator.getPathForIntegerKey(parentContext, key)
 
 	if !parentContext.IsRoot() && !parentContext.Json.KeyPresent {
 		return validator.getEmptyJsonContext(path)
@@ -269,8 +439,12 @@ func (validator *Validator) getJsonContextForIntegerKey(parentContext *Validatio
 	return validator.getEmptyJsonContext(path)
 }
 
+func (validator *Validator) getPathForStringKey(parentContext *ValidationContext, key string) string {
+	return parentContext.RootContext.Options.pathFormatter().Append(parentContext.Json.Path, KeySegment(key))
+}
+
 func (validator *Validator) getJsonContextForStringKey(parentContext *ValidationContext, key string) *JsonContext {
-	path := strings.TrimLeft(parentContext.Json.Path+"."+key, ".")
+	path := validator.getPathForStringKey(parentContext, key)
 
 	if !parentContext.IsRoot() && !parentContext.Json.KeyPresent {
 		return validator.getEmptyJsonContext(path)
@@ -303,6 +477,10 @@ func (validator *Validator) getEmptyJsonContext(path string) *JsonContext {
 }
 
 func (validator *Validator) isEmptyValue(value any) bool {
+	if isNumber(value) {
+		return isZeroNumber(value)
+	}
+
 	switch value := reflect.ValueOf(value); value.Kind() {
 	case reflect.Map:
 		return len(value.MapKeys()) == 0
@@ -314,11 +492,6 @@ func (validator *Validator) isEmptyValue(value any) bool {
 		return value.Bool() == true
 	case reflect.String:
 		return value.String() == ""
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
-		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		return value.Int() == 0
-	case reflect.Float32, reflect.Float64:
-		return value.Float() == 0
 	}
 
 	return false
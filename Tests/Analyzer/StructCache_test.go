@@ -540,3 +540,31 @@ func Test_it_can_analyze_multi_objects(t *testing.T) {
 	require.Equal(t, "Key", fieldCache.Children.All()[0].Children.All()[0].StructKey)
 	require.Equal(t, "Value", fieldCache.Children.All()[1].Children.All()[0].StructKey)
 }
+
+func Test_it_can_analyze_map_keys(t *testing.T) {
+	// Arrange
+	validator := JsonValidator.New()
+	type simpleStruct struct {
+		Names  map[string]string `json:"names" validation:"object|dive|keys|uuid|endkeys|string"`
+		Counts map[int]int       `json:"counts" validation:"object"`
+	}
+
+	// Act
+	var data simpleStruct
+	fieldCache, err := validator.Analyze(&data)
+
+	// Assert
+	require.NoError(t, err)
+
+	names := fieldCache.Children.All()[0]
+	require.NotNil(t, names.Key)
+	require.Len(t, names.Key.ValidationTag.Rules, 1)
+	require.Equal(t, "uuid", names.Key.ValidationTag.Rules[0].Name)
+	require.Len(t, names.Children.All()[0].ValidationTag.Rules, 1)
+	require.Equal(t, "string", names.Children.All()[0].ValidationTag.Rules[0].Name)
+
+	counts := fieldCache.Children.All()[1]
+	require.NotNil(t, counts.Key)
+	require.Len(t, counts.Key.ValidationTag.Rules, 1)
+	require.Equal(t, "mapKey", counts.Key.ValidationTag.Rules[0].Name)
+}
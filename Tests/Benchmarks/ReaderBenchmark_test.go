@@ -0,0 +1,81 @@
+package Benchmarks
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"strings"
+	"testing"
+)
+
+type readerBenchmarkPayment struct {
+	ID       int    `json:"id" validation:"required|integer|min:0"`
+	Currency string `json:"currency" validation:"required|string|len:3"`
+}
+
+type readerBenchmarkBatch struct {
+	Payments []readerBenchmarkPayment `json:"payments" validation:"required|array"`
+}
+
+type readerBenchmarkBoundedBatch struct {
+	Payments []readerBenchmarkPayment `json:"payments" validation:"required|array|lenMax:1000"`
+}
+
+func readerBenchmarkPayments(count int) string {
+	payments := make([]string, count)
+
+	for i := range payments {
+		payments[i] = fmt.Sprintf(`{"id": %d, "currency": "DKK"}`, i)
+	}
+
+	return "[" + strings.Join(payments, ",") + "]"
+}
+
+// BenchmarkReaderStreamedArray validates a top-level array, which ValidateReader streams one entry at a time.
+func BenchmarkReaderStreamedArray(b *testing.B) {
+	body := []byte(readerBenchmarkPayments(1000))
+	validator := JsonValidator.New()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var targetSlice []readerBenchmarkPayment
+
+		if err := validator.ValidateReader(context.Background(), bytes.NewReader(body), &targetSlice); err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+// BenchmarkReaderObjectBody validates the same entries within an object, which ValidateReader streams as the array field only has presence and type rules.
+func BenchmarkReaderObjectBody(b *testing.B) {
+	body := []byte(`{"payments": ` + readerBenchmarkPayments(1000) + `}`)
+	validator := JsonValidator.New()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var targetStruct readerBenchmarkBatch
+
+		if err := validator.ValidateReader(context.Background(), bytes.NewReader(body), &targetStruct); err != nil {
+			b.Error(err)
+		}
+	}
+}
+
+// BenchmarkReaderBufferedObjectBody validates the same object with a lenMax rule on the array, which ValidateReader reads in full before validation.
+func BenchmarkReaderBufferedObjectBody(b *testing.B) {
+	body := []byte(`{"payments": ` + readerBenchmarkPayments(1000) + `}`)
+	validator := JsonValidator.New()
+
+	b.ReportAllocs()
+
+	for i := 0; i < b.N; i++ {
+		var targetStruct readerBenchmarkBoundedBatch
+
+		if err := validator.ValidateReader(context.Background(), bytes.NewReader(body), &targetStruct); err != nil {
+			b.Error(err)
+		}
+	}
+}
@@ -0,0 +1,162 @@
+package Tests
+
+import (
+	"context"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type contextTestKey struct{}
+
+func Test_it_can_validate_with_a_context(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 10}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer|min:1"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().ValidateContext(context.Background(), jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 10, data.Amount)
+}
+
+func Test_it_stops_validation_of_a_cancelled_context(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 10}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer|min:1"`
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	var data testData
+	err := JsonValidator.New().ValidateContext(ctx, jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 0, data.Amount)
+}
+
+func Test_it_stops_traversal_when_the_context_is_cancelled_during_validation(t *testing.T) {
+	// Arrange
+	validator := JsonValidator.New()
+	ctx, cancel := context.WithCancel(context.Background())
+	validatedEntries := 0
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "cancelAfterTwo",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			validatedEntries++
+
+			if validatedEntries == 2 {
+				cancel()
+			}
+
+			return "", true
+		},
+	})
+
+	jsonString := []byte(`{"items": [1, 2, 3, 4, 5]}`)
+	type testData struct {
+		Items []int `json:"items" validation:"required|array|dive|cancelAfterTwo"`
+	}
+
+	// Act
+	var data testData
+	err := validator.ValidateContext(ctx, jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.Equal(t, 2, validatedEntries)
+}
+
+func Test_it_exposes_the_context_to_rules(t *testing.T) {
+	// Arrange
+	validator := JsonValidator.New()
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "merchantCurrency",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			if context.Validation.Json.Value == context.Context().Value(contextTestKey{}) {
+				return "", true
+			}
+
+			return "Must be the currency of the merchant", false
+		},
+	})
+
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"currency": "SEK"}`)
+	type testData struct {
+		Currency string `json:"currency" validation:"required|merchantCurrency"`
+	}
+	ctx := context.WithValue(context.Background(), contextTestKey{}, "DKK")
+
+	// Act
+	var data testData
+	err := validator.ValidateContext(ctx, jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	errWithMatchingCurrency := validator.ValidateContext(context.WithValue(ctx, contextTestKey{}, "SEK"), jsonString, &data)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("currency", "merchantCurrency"))
+	require.NoError(t, errWithMatchingCurrency)
+}
+
+func Test_it_exposes_a_background_context_to_rules_when_validating_without_a_context(t *testing.T) {
+	// Arrange
+	validator := JsonValidator.New()
+	var ruleContext context.Context
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "captureContext",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			ruleContext = context.Context()
+
+			return "", true
+		},
+	})
+
+	jsonString := []byte(`{"currency": "DKK"}`)
+	type testData struct {
+		Currency string `json:"currency" validation:"required|captureContext"`
+	}
+
+	// Act
+	var data testData
+	err := validator.Validate(jsonString, &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.NotNil(t, ruleContext)
+}
+
+func Test_it_can_validate_generically_with_a_context(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 10}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer|min:1"`
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	typedValidator, _ := JsonValidator.ValidatorFor[testData]()
+
+	// Act
+	data, err := JsonValidator.ValidateContext[testData](context.Background(), jsonString)
+	typedData, typedErr := typedValidator.ValidateContext(context.Background(), jsonString)
+	cancel()
+	_, cancelledErr := typedValidator.ValidateContext(ctx, jsonString)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 10, data.Amount)
+	require.NoError(t, typedErr)
+	require.Equal(t, 10, typedData.Amount)
+	require.ErrorIs(t, cancelledErr, context.Canceled)
+}
@@ -1,7 +1,9 @@
 package Tests
 
 import (
+	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/epay-technology/json-validator-go/JsonValidator"
 	"github.com/stretchr/testify/require"
 	"testing"
@@ -35,3 +37,67 @@ func Test_it_can_register_custom_rules(t *testing.T) {
 	require.True(t, errorBag.HasFailedKeyAndRule("Data", "MyRule"))
 	require.Equal(t, 1, errorBag.CountErrors())
 }
+
+func Test_it_gives_custom_rules_numbers_as_json_numbers(t *testing.T) {
+	// Setup
+	validator := JsonValidator.New()
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "evenCents",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			// Numbers are json.Number, so asserting float64 would never match
+			_, isFloat := context.Validation.Json.Value.(float64)
+			_, isJsonNumber := context.Validation.Json.Value.(json.Number)
+			amount, isNumber := context.Validation.Json.Float64()
+
+			return "Must be an even number of cents", !isFloat && isJsonNumber && isNumber && int64(amount*100)%2 == 0
+		},
+	})
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "exactlyAbove",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			// Number keeps the exact value, where float64 would round 9007199254740993 down to the param
+			value, isNumber := context.Validation.Json.Number()
+
+			return "Must be above " + context.GetParam(0), isNumber && value.Cmp(context.GetNumberParam(0)) > 0
+		},
+	})
+
+	type testData struct {
+		Amount float64 `json:"amount" validation:"evenCents"`
+		Id     uint64  `json:"id" validation:"exactlyAbove:9007199254740992"`
+	}
+
+	cases := []struct {
+		jsonString    string
+		expectedPaths []string
+	}{
+		{`{"amount": 10.5, "id": 9007199254740993}`, nil},
+		{`{"amount": 10.51, "id": 9007199254740992}`, []string{"amount", "id"}},
+		{`{"amount": "10.5", "id": "9007199254740993"}`, []string{"amount", "id"}},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := validator.Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.expectedPaths == nil {
+				require.NoError(t, err)
+				return
+			}
+
+			require.NotNil(t, errorBag, err)
+			require.Equal(t, len(testCase.expectedPaths), errorBag.CountErrors(), errorBag.Error())
+
+			for _, path := range testCase.expectedPaths {
+				require.NotEmpty(t, errorBag.GetErrorsForKey(path), errorBag.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package Tests
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type compositionTestLine struct {
+	Amount   int    `json:"amount" validation:"required|int|min:1"`
+	Currency string `json:"currency" validation:"required|alpha3Currency"`
+}
+
+type compositionTestHeader struct {
+	Reference string `json:"reference" validation:"required|string"`
+}
+
+func validateComposition(t *testing.T, jsonString string, target any, options ...JsonValidator.Option) *JsonValidator.ErrorBag {
+	var errorBag *JsonValidator.ErrorBag
+
+	err := JsonValidator.New().Validate([]byte(jsonString), target, options...)
+	require.True(t, errors.As(err, &errorBag))
+
+	return errorBag
+}
+
+func Test_it_merges_error_bags_of_parts_validated_on_their_own(t *testing.T) {
+	// Arrange
+	var header compositionTestHeader
+	var line compositionTestLine
+	headerErrors := validateComposition(t, `{}`, &header)
+	lineErrors := validateComposition(t, `{"amount": 0, "currency": "DKK"}`, &line)
+
+	// Act
+	merged := headerErrors.WithPrefix("header")
+	merged.Merge(lineErrors.WithPrefix("lines.3"))
+
+	// Assert
+	require.Equal(t, []string{"header.reference", "lines.3.amount"}, merged.GetPaths())
+	require.Equal(t, []string{"[required]: Is a required non-nullable field"}, merged.GetErrorsForKey("header.reference"))
+	require.Equal(t, []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(3), {Key: "amount"}}, merged.GetFieldErrorsForKey("lines.3.amount")[0].Segments)
+	require.Equal(t, 2, merged.CountErrors())
+}
+
+func Test_it_prefixes_paths_with_the_path_formatter_of_the_bag(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter    JsonValidator.PathFormatter
+		prefix       string
+		expectedPath string
+	}{
+		{JsonValidator.DottedPath, "lines.3", "lines.3.amount"},
+		{JsonValidator.JsonPointer, "/lines/3", "/lines/3/amount"},
+		{JsonValidator.JsonPointer, "/a~1b/3", "/a~1b/3/amount"},
+		{JsonValidator.JsonPath, "$.lines[3]", "$.lines[3].amount"},
+		{JsonValidator.JsonPath, "$['a.b'][3]", "$['a.b'][3].amount"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var line compositionTestLine
+			lineErrors := validateComposition(t, `{"amount": 0, "currency": "DKK"}`, &line, JsonValidator.WithPathFormatter(testCase.formatter))
+
+			// Act
+			prefixed := lineErrors.WithPrefix(testCase.prefix)
+
+			// Assert
+			require.Equal(t, []string{testCase.expectedPath}, prefixed.GetPaths())
+			require.True(t, prefixed.GetFieldErrors()[0].Segments[1].IsIndex())
+		})
+	}
+}
+
+func Test_it_summarizes_merged_error_bags_of_array_entries(t *testing.T) {
+	// Arrange
+	merged := &JsonValidator.ErrorBag{}
+
+	// Act
+	for i := 0; i < 3; i++ {
+		var line compositionTestLine
+		merged.Merge(validateComposition(t, `{"amount": 1}`, &line).WithPrefix(fmt.Sprintf("lines.%d", i)))
+	}
+
+	// Assert
+	summaries := merged.Summarize(0)
+	require.Len(t, summaries, 1)
+	require.Equal(t, "lines.*.currency", summaries[0].Path)
+	require.Equal(t, [][]int{{0}, {1}, {2}}, summaries[0].Indexes)
+}
+
+func Test_it_extracts_and_removes_the_errors_of_a_path(t *testing.T) {
+	// Arrange
+	errorBag := &JsonValidator.ErrorBag{}
+	errorBag.AddError("header.reference", "[required]: Is required")
+	errorBag.AddError("lines.0.amount", "[min]: Must be at least 1")
+	errorBag.AddError("lines.1.currency", "[required]: Is required")
+	errorBag.AddError("linesTotal", "[max]: Must be at most 100")
+
+	// Act
+	lines := errorBag.ForPath("lines")
+	firstLine := errorBag.ForPath("lines.0")
+	withoutLines := errorBag.Without("lines")
+	withoutFirstLine := errorBag.Without("lines.0")
+
+	// Assert
+	require.Equal(t, []string{"0.amount", "1.currency"}, lines.GetPaths())
+	require.Equal(t, []string{"amount"}, firstLine.GetPaths())
+	require.Equal(t, []string{"header.reference", "linesTotal"}, withoutLines.GetPaths())
+	require.Equal(t, []string{"header.reference", "lines.1.currency", "linesTotal"}, withoutFirstLine.GetPaths())
+	require.Equal(t, 4, errorBag.CountErrors())
+}
+
+func Test_it_restores_the_paths_of_a_prefixed_bag_when_extracting_the_prefix(t *testing.T) {
+	// Arrange
+	var line compositionTestLine
+	lineErrors := validateComposition(t, `{"currency": "ABC"}`, &line, JsonValidator.WithPathFormatter(JsonValidator.JsonPath))
+
+	// Act
+	extracted := lineErrors.WithPrefix("$.lines[3]").ForPath("$.lines[3]")
+
+	// Assert
+	require.Equal(t, lineErrors.GetFieldErrors(), extracted.GetFieldErrors())
+	require.Equal(t, lineErrors.Errors, extracted.Errors)
+}
+
+func Test_it_filters_errors_with_a_predicate(t *testing.T) {
+	// Arrange
+	var line compositionTestLine
+	lineErrors := validateComposition(t, `{"amount": 0}`, &line)
+
+	// Act
+	presenceErrors := lineErrors.Filter(func(fieldError JsonValidator.FieldError) bool {
+		return fieldError.Category == JsonValidator.PresenceCategory
+	})
+
+	// Assert
+	require.Equal(t, []string{"currency"}, presenceErrors.GetPaths())
+	require.True(t, presenceErrors.IsInvalid())
+	require.True(t, lineErrors.Filter(func(JsonValidator.FieldError) bool { return false }).IsValid())
+}
+
+func Test_it_adds_structured_errors(t *testing.T) {
+	// Arrange
+	var line compositionTestLine
+	lineErrors := validateComposition(t, `{"amount": 1, "currency": "ABC"}`, &line, JsonValidator.WithPathFormatter(JsonValidator.JsonPointer))
+
+	// Act
+	lineErrors.Add(
+		JsonValidator.FieldError{Path: "/amount", Rule: "limit", Params: []string{"1000"}, Message: "Exceeds the limit of the merchant"},
+		JsonValidator.FieldError{Segments: []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(2)}, Rule: "duplicate", Message: "Is a duplicated line", Category: JsonValidator.StructureCategory},
+	)
+
+	// Assert
+	require.Equal(t, []string{"/currency", "/amount", "/lines/2"}, lineErrors.GetPaths())
+	require.Equal(t, []string{"[limit]: Exceeds the limit of the merchant"}, lineErrors.GetErrorsForKey("/amount"))
+	require.Equal(t, JsonValidator.ValueCategory, lineErrors.GetFieldErrorsForKey("/amount")[0].Category)
+	require.Equal(t, []JsonValidator.PathSegment{{Key: "amount"}}, lineErrors.GetFieldErrorsForKey("/amount")[0].Segments)
+	require.Equal(t, []string{"[duplicate]: Is a duplicated line"}, lineErrors.GetErrorsForKey("/lines/2"))
+}
+
+func Test_it_merges_errors_only_added_to_the_errors_map(t *testing.T) {
+	// Arrange
+	legacy := &JsonValidator.ErrorBag{Errors: map[string][]string{"reference": {"[required]: Is required"}}}
+	merged := &JsonValidator.ErrorBag{}
+
+	// Act
+	merged.Merge(legacy, nil)
+
+	// Assert
+	require.Equal(t, []string{"[required]: Is required"}, merged.GetErrorsForKey("reference"))
+	require.Len(t, merged.GetFieldErrors(), 1)
+	require.Equal(t, "required", merged.GetFieldErrors()[0].Rule)
+}
+
+func Test_it_parses_paths_given_to_the_bag_with_the_path_formatter(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter        JsonValidator.PathFormatter
+		path             string
+		expectedSegments []JsonValidator.PathSegment
+	}{
+		{JsonValidator.DottedPath, "lines.3.amount", []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(3), {Key: "amount"}}},
+		{JsonValidator.JsonPointer, "/lines/3/a~1b", []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(3), {Key: "a/b"}}},
+		{JsonValidator.JsonPointer, "lines/3", []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(3)}},
+		{JsonValidator.JsonPointer, "/", []JsonValidator.PathSegment{{Key: ""}}},
+		{JsonValidator.JsonPath, "$.lines[3]['a.b']", []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(3), {Key: "a.b"}}},
+		{JsonValidator.JsonPath, "$[x].amount", []JsonValidator.PathSegment{{Key: "x"}, {Key: "amount"}}},
+		{JsonValidator.JsonPath, "$.lines[-1]", []JsonValidator.PathSegment{{Key: "lines"}, {Key: "-1"}}},
+		{JsonValidator.JsonPath, "$.lines[3", []JsonValidator.PathSegment{{Key: "lines"}, {Key: "3"}}},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var line compositionTestLine
+			added := validateComposition(t, `{"amount": 1, "currency": "ABC"}`, &line, JsonValidator.WithPathFormatter(testCase.formatter))
+			described := validateComposition(t, `{"amount": 1, "currency": "ABC"}`, &line, JsonValidator.WithPathFormatter(testCase.formatter))
+
+			// Act
+			added.Add(JsonValidator.FieldError{Path: testCase.path, Rule: "custom", Message: "Is invalid"})
+			described.AddError(testCase.path, "[custom]: Is invalid")
+
+			// Assert
+			require.Equal(t, testCase.expectedSegments, added.GetFieldErrorsForKey(testCase.path)[0].Segments)
+			require.Equal(t, testCase.expectedSegments, described.GetFieldErrorsForKey(testCase.path)[0].Segments)
+		})
+	}
+}
+
+func Test_it_handles_nil_bags_when_merging(t *testing.T) {
+	// Arrange
+	var nilBag *JsonValidator.ErrorBag
+	errorBag := &JsonValidator.ErrorBag{}
+	errorBag.AddError("reference", "[required]: Is required")
+
+	// Act
+	nilBag.Merge(errorBag)
+	errorBag.Merge(nil, nilBag)
+
+	// Assert
+	require.Nil(t, nilBag)
+	require.Equal(t, 1, errorBag.CountErrors())
+	require.True(t, nilBag.Without("reference").IsValid())
+}
@@ -0,0 +1,125 @@
+package Tests
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type categoryTestPayment struct {
+	Amount int `json:"amount" validation:"required|int|min:1"`
+}
+
+func Test_it_categorizes_invalid_json(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": }`)
+
+	// Act
+	var data categoryTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrInvalidJSON)
+	require.NotErrorIs(t, err, JsonValidator.ErrValidationFailed)
+}
+
+func Test_it_categorizes_failed_validation(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 0}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data categoryTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrValidationFailed)
+	require.NotErrorIs(t, err, JsonValidator.ErrInvalidJSON)
+	require.True(t, errors.As(err, &errorBag))
+}
+
+func Test_it_returns_invalid_schemas_as_errors_instead_of_panicking(t *testing.T) {
+	// Setup
+	type unknownRule struct {
+		Amount int `json:"amount" validation:"required|noSuchRule"`
+	}
+
+	type invalidParam struct {
+		Amount int `json:"amount" validation:"required|min:abc"`
+	}
+
+	type missingNeighbor struct {
+		Amount   int    `json:"amount" validation:"missingUnless:NoSuchField,DKK"`
+		Currency string `json:"currency"`
+	}
+
+	cases := []struct {
+		jsonString string
+		target     any
+	}{
+		{`{"amount": 1}`, &unknownRule{}},
+		{`{"amount": 1}`, &invalidParam{}},
+		{`{"amount": 1}`, &missingNeighbor{}},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Act
+			var err error
+
+			require.NotPanics(t, func() {
+				err = JsonValidator.New().Validate([]byte(testCase.jsonString), testCase.target)
+			})
+
+			// Assert
+			require.ErrorIs(t, err, JsonValidator.ErrInvalidSchema)
+		})
+	}
+}
+
+func Test_it_categorizes_unsupported_targets(t *testing.T) {
+	// Setup
+	var callback func()
+
+	cases := []any{
+		nil,
+		categoryTestPayment{},
+		&callback,
+	}
+
+	for i, target := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Act
+			err := JsonValidator.New().Validate([]byte(`{"amount": 1}`), target)
+
+			// Assert
+			require.ErrorIs(t, err, JsonValidator.ErrUnsupportedTarget)
+		})
+	}
+}
+
+func Test_it_does_not_recover_panics_of_custom_rules(t *testing.T) {
+	// Arrange
+	validator := JsonValidator.New()
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "panicking",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			panic("custom rule failure")
+		},
+	})
+
+	type testData struct {
+		Amount int `json:"amount" validation:"panicking"`
+	}
+
+	// Act
+	act := func() {
+		var data testData
+		_ = validator.Validate([]byte(`{"amount": 1}`), &data)
+	}
+
+	// Assert
+	require.PanicsWithValue(t, "custom rule failure", act)
+}
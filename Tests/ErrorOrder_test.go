@@ -0,0 +1,107 @@
+package Tests
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type orderTestLine struct {
+	Text   string `json:"text" validation:"required|string"`
+	Amount int    `json:"amount" validation:"required|int"`
+}
+
+type orderTestOrder struct {
+	Zulu  string            `json:"zulu" validation:"required"`
+	Lines []orderTestLine   `json:"lines" validation:"required|array"`
+	Tags  map[string]string `json:"tags" validation:"required|object|dive|string"`
+	Alpha string            `json:"alpha" validation:"required"`
+}
+
+func Test_it_reports_errors_in_struct_and_json_order(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"lines": [{}, {"text": 1}, {"amount": "x", "text": 2}], "tags": {"c": 1, "a": 2, "b": 3}}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data orderTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{
+		"zulu",
+		"lines.0.text",
+		"lines.0.amount",
+		"lines.1.text",
+		"lines.1.amount",
+		"lines.2.text",
+		"lines.2.amount",
+		"tags.a",
+		"tags.b",
+		"tags.c",
+		"alpha",
+	}, errorBag.GetPaths())
+}
+
+func Test_it_orders_map_entries_by_key_and_duplicated_keys_last(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"zulu": "z", "tags": {"9": 1, "10": 2}, "zulu": "z", "lines": [], "alpha": 1}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data orderTestOrder
+	err := JsonValidator.New(JsonValidator.WithDuplicateKeyDetection()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"tags.10", "tags.9", "zulu"}, errorBag.GetPaths())
+	require.Equal(t, "duplicateKey", errorBag.GetFieldErrors()[2].Rule)
+}
+
+func Test_it_marshals_errors_in_order(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"lines": [{"text": "a", "amount": 1}], "tags": {"b": 1, "a": 2}}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data orderTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	jsonBytes, marshalErr := json.Marshal(errorBag)
+
+	// Assert
+	require.NoError(t, marshalErr)
+	require.Equal(t, `{"Errors":{"zulu":["[required]: Is a required non-nullable field"],"tags.a":["[string]: Must be a string"],"tags.b":["[string]: Must be a string"],"alpha":["[required]: Is a required non-nullable field"]}}`, string(jsonBytes))
+}
+
+func Test_it_produces_the_same_error_output_for_every_validation(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"lines": [{}], "tags": {"e": 1, "d": 2, "c": 3, "b": 4, "a": 5}}`)
+	var outputs []string
+
+	// Act
+	for i := 0; i < 20; i++ {
+		var data orderTestOrder
+		err := JsonValidator.New().Validate(jsonString, &data)
+		outputs = append(outputs, err.Error())
+	}
+
+	// Assert
+	for _, output := range outputs {
+		require.Equal(t, outputs[0], output)
+	}
+}
+
+func Test_it_orders_paths_only_added_to_the_map_last(t *testing.T) {
+	// Arrange
+	errorBag := &JsonValidator.ErrorBag{Errors: map[string][]string{"b": {"Is invalid"}, "a": {"Is invalid"}}}
+
+	// Act
+	errorBag.AddError("c", "[custom]: Is invalid")
+
+	// Assert
+	require.Equal(t, []string{"c", "a", "b"}, errorBag.GetPaths())
+}
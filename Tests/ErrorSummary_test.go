@@ -0,0 +1,143 @@
+package Tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+type summaryTestLine struct {
+	Amount int `json:"amount" validation:"required|int"`
+}
+
+type summaryTestItem struct {
+	Currency string            `json:"currency" validation:"required|alpha3Currency"`
+	Lines    []summaryTestLine `json:"lines" validation:"array"`
+}
+
+type summaryTestImport struct {
+	Reference string            `json:"reference" validation:"required|string"`
+	Items     []summaryTestItem `json:"items" validation:"required|array"`
+}
+
+func Test_it_summarizes_errors_of_array_entries_with_wildcards(t *testing.T) {
+	// Arrange
+	items := make([]string, 5000)
+
+	for i := range items {
+		items[i] = `{"lines": []}`
+	}
+
+	jsonString := []byte(fmt.Sprintf(`{"items": [%s]}`, strings.Join(items, ",")))
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data summaryTestImport
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	summaries := errorBag.Summarize(3)
+
+	// Assert
+	require.Equal(t, []JsonValidator.ErrorSummary{
+		{Path: "reference", Rule: "required", Message: "Is a required non-nullable field", Category: JsonValidator.PresenceCategory, Count: 1},
+		{Path: "items.*.currency", Rule: "required", Message: "Is a required non-nullable field", Category: JsonValidator.PresenceCategory, Count: 5000, Indexes: [][]int{{0}, {1}, {2}}},
+	}, summaries)
+}
+
+func Test_it_summarizes_errors_of_nested_arrays_by_rule(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{
+		"reference": "import-1",
+		"items": [
+			{"currency": "DKK", "lines": [{"amount": 1}, {}, {"amount": "1"}]},
+			{"currency": "ABC", "lines": [{}]},
+			{"currency": "XYZ", "lines": [{"amount": "1"}]}
+		]
+	}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data summaryTestImport
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	summaries := errorBag.Summarize(0)
+
+	// Assert
+	require.Equal(t, []JsonValidator.ErrorSummary{
+		{Path: "items.*.lines.*.amount", Rule: "required", Message: "Is a required non-nullable field", Category: JsonValidator.PresenceCategory, Count: 2, Indexes: [][]int{{0, 1}, {1, 0}}},
+		{Path: "items.*.lines.*.amount", Rule: "int", Message: "Must be an integer", Category: JsonValidator.TypeCategory, Count: 2, Indexes: [][]int{{0, 2}, {2, 0}}},
+		{Path: "items.*.currency", Rule: "alpha3Currency", Message: "Must be a valid alpha-3 currency code - [ABC] given", Category: JsonValidator.ValueCategory, Count: 2, Indexes: [][]int{{1}, {2}}},
+	}, summaries)
+}
+
+func Test_it_formats_summarized_paths_with_the_path_formatter(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter    JsonValidator.PathFormatter
+		expectedPath string
+	}{
+		{JsonValidator.DottedPath, "items.*.lines.*.amount"},
+		{JsonValidator.JsonPointer, "/items/*/lines/*/amount"},
+		{JsonValidator.JsonPath, "$.items[*].lines[*].amount"},
+	}
+
+	jsonString := []byte(`{"reference": "import-1", "items": [{"currency": "DKK", "lines": [{}]}]}`)
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data summaryTestImport
+			err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithPathFormatter(testCase.formatter))
+			_ = errors.As(err, &errorBag)
+			summaries := errorBag.Summarize(0)
+
+			// Assert
+			require.Len(t, summaries, 1)
+			require.Equal(t, testCase.expectedPath, summaries[0].Path)
+		})
+	}
+}
+
+func Test_it_marshals_error_summaries(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": "import-1", "items": [{"lines": []}, {"lines": []}]}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data summaryTestImport
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	jsonBytes, _ := json.Marshal(errorBag.Summarize(1))
+
+	// Assert
+	require.JSONEq(t, `[{
+		"path": "items.*.currency",
+		"rule": "required",
+		"message": "Is a required non-nullable field",
+		"category": "presence",
+		"count": 2,
+		"indexes": [[0]]
+	}]`, string(jsonBytes))
+}
+
+func Test_it_summarizes_errors_added_by_path(t *testing.T) {
+	// Arrange
+	errorBag := &JsonValidator.ErrorBag{Errors: map[string][]string{}}
+	errorBag.AddError("items.0.currency", "[required]: Is required")
+	errorBag.AddError("items.1.currency", "[required]: Is required")
+
+	// Act
+	summaries := errorBag.Summarize(0)
+
+	// Assert
+	require.Equal(t, []JsonValidator.ErrorSummary{
+		{Path: "items.*.currency", Rule: "required", Message: "Is required", Category: JsonValidator.ValueCategory, Count: 2, Indexes: [][]int{{0}, {1}}},
+	}, summaries)
+}
@@ -0,0 +1,140 @@
+package Tests
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type fieldErrorTestLine struct {
+	Amount int `json:"amount" validation:"required|int|min:1"`
+}
+
+type fieldErrorTestOrder struct {
+	Reference string               `json:"reference" validation:"required|string"`
+	Lines     []fieldErrorTestLine `json:"lines" validation:"required|array"`
+	Note      uint8                `json:"note"`
+}
+
+func Test_it_describes_failed_rules_with_structured_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": 42, "lines": [{"amount": 10}, {"amount": 0}]}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data fieldErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []JsonValidator.FieldError{
+		{
+			Path:     "reference",
+			Segments: []JsonValidator.PathSegment{{Key: "reference"}},
+			Rule:     "string",
+			Message:  "Must be a string",
+			Value:    json.Number("42"),
+			Category: JsonValidator.TypeCategory,
+		},
+		{
+			Path:     "lines.1.amount",
+			Segments: []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(1), {Key: "amount"}},
+			Rule:     "min",
+			Params:   []string{"1"},
+			Message:  "Must be a number greater than or equal to 1",
+			Value:    json.Number("0"),
+			Category: JsonValidator.ValueCategory,
+		},
+	}, errorBag.GetFieldErrors())
+	require.Equal(t, []string{"[min]: Must be a number greater than or equal to 1"}, errorBag.GetErrorsForKey("lines.1.amount"))
+}
+
+func Test_it_categorizes_presence_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"lines": []}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data fieldErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	fieldErrors := errorBag.GetFieldErrorsForKey("reference")
+	require.Len(t, fieldErrors, 1)
+	require.Equal(t, "required", fieldErrors[0].Rule)
+	require.Equal(t, JsonValidator.PresenceCategory, fieldErrors[0].Category)
+	require.Nil(t, fieldErrors[0].Value)
+}
+
+func Test_it_describes_unmarshal_errors_with_structured_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": "A1", "lines": [], "note": 300}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data fieldErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	fieldErrors := errorBag.GetFieldErrors()
+	require.Len(t, fieldErrors, 1)
+	require.Equal(t, "note", fieldErrors[0].Path)
+	require.Equal(t, "type", fieldErrors[0].Rule)
+	require.Equal(t, []string{"uint8"}, fieldErrors[0].Params)
+	require.Equal(t, JsonValidator.TypeCategory, fieldErrors[0].Category)
+	require.Equal(t, json.Number("300"), fieldErrors[0].Value)
+}
+
+func Test_it_categorizes_unknown_and_duplicated_keys_as_structure_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": "A1", "reference": "A2", "lines": [], "unknown": true}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data fieldErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithStrictMode(), JsonValidator.WithDuplicateKeyDetection())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Len(t, errorBag.GetFieldErrors(), 2)
+	require.Equal(t, JsonValidator.StructureCategory, errorBag.GetFieldErrorsForKey("unknown")[0].Category)
+	require.Equal(t, true, errorBag.GetFieldErrorsForKey("unknown")[0].Value)
+	require.Equal(t, JsonValidator.StructureCategory, errorBag.GetFieldErrorsForKey("reference")[0].Category)
+	require.True(t, errorBag.HasFailedKeyAndRule("reference", "duplicateKey"))
+}
+
+func Test_it_parses_errors_added_as_descriptions(t *testing.T) {
+	// Arrange
+	errorBag := &JsonValidator.ErrorBag{}
+
+	// Act
+	errorBag.AddError("lines.amount", "[custom]: Is invalid")
+	errorBag.AddError("lines", "Is invalid")
+
+	// Assert
+	fieldErrors := errorBag.GetFieldErrors()
+	require.Equal(t, "custom", fieldErrors[0].Rule)
+	require.Equal(t, "Is invalid", fieldErrors[0].Message)
+	require.Equal(t, []JsonValidator.PathSegment{{Key: "lines"}, {Key: "amount"}}, fieldErrors[0].Segments)
+	require.Equal(t, "", fieldErrors[1].Rule)
+	require.Equal(t, []string{"Is invalid"}, errorBag.GetErrorsForKey("lines"))
+	require.True(t, errorBag.HasFailedKeyAndRule("lines.amount", "custom"))
+}
+
+func Test_path_segments_are_keys_unless_their_kind_says_otherwise(t *testing.T) {
+	// Arrange
+	segments := []JsonValidator.PathSegment{{Key: "lines"}, JsonValidator.IndexSegment(0), JsonValidator.KeySegment("0")}
+
+	// Act
+	kinds := []JsonValidator.SegmentKind{segments[0].Kind, segments[1].Kind, segments[2].Kind}
+
+	// Assert
+	require.Equal(t, []JsonValidator.SegmentKind{JsonValidator.KeyKind, JsonValidator.IndexKind, JsonValidator.KeyKind}, kinds)
+	require.True(t, segments[1].IsIndex())
+	require.False(t, segments[2].IsIndex())
+	require.Equal(t, "0", segments[2].String())
+}
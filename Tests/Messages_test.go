@@ -0,0 +1,323 @@
+package Tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+type messageTestPayment struct {
+	Amount   int    `json:"amount" validation:"required|int|min:1"`
+	Currency string `json:"currency" validation:"required|in:DKK,EUR"`
+	Email    string `json:"email" validation:"requiredWith:Phone"`
+	Phone    string `json:"phone"`
+	Count    uint8  `json:"count"`
+}
+
+func Test_it_translates_messages_with_the_catalog_of_the_locale(t *testing.T) {
+	// Setup
+	cases := []struct {
+		options          []JsonValidator.Option
+		expectedMessages map[string]string
+	}{
+		{
+			nil,
+			map[string]string{
+				"amount":   "Must be a number greater than or equal to 1",
+				"currency": "Value must be in set: [DKK, EUR] - [SEK] given",
+				"email":    "Is required when phone is present",
+			},
+		},
+		{
+			[]JsonValidator.Option{JsonValidator.WithLocale("da")},
+			map[string]string{
+				"amount":   "Skal være et tal større end eller lig med 1",
+				"currency": "Værdien skal være en af: [DKK, EUR] - [SEK] er givet",
+				"email":    "Er påkrævet, når phone er til stede",
+			},
+		},
+		{
+			[]JsonValidator.Option{JsonValidator.WithLocale("da_DK")},
+			map[string]string{
+				"amount":   "Skal være et tal større end eller lig med 1",
+				"currency": "Værdien skal være en af: [DKK, EUR] - [SEK] er givet",
+				"email":    "Er påkrævet, når phone er til stede",
+			},
+		},
+		{
+			[]JsonValidator.Option{JsonValidator.WithLocale("sv", "en")},
+			map[string]string{
+				"amount":   "Must be a number greater than or equal to 1",
+				"currency": "Value must be in set: [DKK, EUR] - [SEK] given",
+				"email":    "Is required when phone is present",
+			},
+		},
+		{
+			[]JsonValidator.Option{JsonValidator.WithAcceptLanguage("sv-SE, en;q=0.5, da-DK;q=0.8, *;q=0.1")},
+			map[string]string{
+				"amount":   "Skal være et tal større end eller lig med 1",
+				"currency": "Værdien skal være en af: [DKK, EUR] - [SEK] er givet",
+				"email":    "Er påkrævet, når phone er til stede",
+			},
+		},
+		{
+			[]JsonValidator.Option{JsonValidator.WithAcceptLanguage("da;q=0, en")},
+			map[string]string{
+				"amount":   "Must be a number greater than or equal to 1",
+				"currency": "Value must be in set: [DKK, EUR] - [SEK] given",
+				"email":    "Is required when phone is present",
+			},
+		},
+	}
+
+	jsonString := []byte(`{"amount": 0, "currency": "SEK", "phone": "+45 12345678"}`)
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+			messages := map[string]string{}
+
+			// Act
+			var data messageTestPayment
+			err := JsonValidator.New().Validate(jsonString, &data, testCase.options...)
+			_ = errors.As(err, &errorBag)
+
+			for _, fieldError := range errorBag.GetFieldErrors() {
+				messages[fieldError.Path] = fieldError.Message
+			}
+
+			// Assert
+			require.Equal(t, testCase.expectedMessages, messages)
+		})
+	}
+}
+
+func Test_it_translates_type_and_duplicate_key_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 1, "currency": "DKK", "count": 300, "count": 300}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithLocale("da"), JsonValidator.WithDuplicateKeyDetection())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[duplicateKey]: Er en gentaget nøgle"}, errorBag.GetErrorsForKey("count"))
+}
+
+func Test_it_translates_unmarshal_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 1, "currency": "DKK", "count": 300}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[type]: Skal være en værdi, som kan konverteres til [uint8]"}, errorBag.GetErrorsForKey("count"))
+}
+
+func Test_it_prefers_the_most_specific_message_of_a_registered_catalog(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 0, "currency": "SEK"}`)
+	validator := JsonValidator.New()
+	validator.RegisterCatalog("sv", JsonValidator.MessageCatalog{
+		"min":          "Måste vara minst {0}",
+		"in":           "Måste vara en av: {params}",
+		"in:DKK,EUR":   "Endast DKK och EUR stöds - {value} angavs vid {path}",
+		"required":     "Är obligatoriskt",
+		"requiredWith": "Är obligatoriskt när {fields} anges",
+	})
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := validator.Validate(jsonString, &data, JsonValidator.WithLocale("sv"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[min]: Måste vara minst 1"}, errorBag.GetErrorsForKey("amount"))
+	require.Equal(t, []string{"[in]: Endast DKK och EUR stöds - SEK angavs vid currency"}, errorBag.GetErrorsForKey("currency"))
+}
+
+func Test_it_keeps_the_message_of_the_rule_when_the_catalog_has_none(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 0, "currency": "DKK"}`)
+	validator := JsonValidator.New()
+	validator.RegisterCatalog("sv", JsonValidator.MessageCatalog{
+		"in": "Måste vara en av: {params}",
+	})
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := validator.Validate(jsonString, &data, JsonValidator.WithLocale("sv"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[min]: Must be a number greater than or equal to 1"}, errorBag.GetErrorsForKey("amount"))
+}
+
+func Test_it_keeps_the_message_of_the_rule_when_the_message_uses_a_missing_param(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 0, "currency": "DKK"}`)
+	validator := JsonValidator.New()
+	validator.RegisterCatalog("sv", JsonValidator.MessageCatalog{
+		"min": "Måste vara mellan {0} och {1}",
+	})
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := validator.Validate(jsonString, &data, JsonValidator.WithLocale("sv"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[min]: Must be a number greater than or equal to 1"}, errorBag.GetErrorsForKey("amount"))
+}
+
+func Test_it_merges_registered_catalogs_into_the_shipped_catalogs(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"amount": 0}`)
+	validator := JsonValidator.New()
+	validator.RegisterCatalog("da", JsonValidator.MessageCatalog{
+		"required": "Skal udfyldes",
+	})
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestPayment
+	err := validator.Validate(jsonString, &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[min]: Skal være et tal større end eller lig med 1"}, errorBag.GetErrorsForKey("amount"))
+	require.Equal(t, []string{"[required]: Skal udfyldes"}, errorBag.GetErrorsForKey("currency"))
+}
+
+func Test_it_translates_messages_of_aliases_with_the_message_of_the_rule(t *testing.T) {
+	// Arrange
+	type aliasPayload struct {
+		Name string `json:"name" validation:"minLen:3"`
+	}
+
+	jsonString := []byte(`{"name": "ab"}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data aliasPayload
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[minLen]: Længden skal være mindst 3"}, errorBag.GetErrorsForKey("name"))
+}
+
+func Test_it_translates_messages_of_streamed_entries(t *testing.T) {
+	// Arrange
+	jsonString := `[{"amount": 1, "currency": "DKK"}, {"amount": 1}]`
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data []messageTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(jsonString), &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[required]: Er et påkrævet felt, som ikke må være null"}, errorBag.GetErrorsForKey("1.currency"))
+}
+
+func Test_the_english_catalog_keeps_the_messages_of_the_rules(t *testing.T) {
+	// Setup
+	type englishPayload struct {
+		Required     any          `json:"required" validation:"required"`
+		Present      any          `json:"present" validation:"present"`
+		Len          string       `json:"len" validation:"len:3"`
+		LenMin       string       `json:"lenMin" validation:"lenMin:5"`
+		LenMax       string       `json:"lenMax" validation:"lenMax:1"`
+		LenBetween   any          `json:"lenBetween" validation:"lenBetween:3,4"`
+		MissingIf    any          `json:"missingIf" validation:"missingIf:Sibling,123"`
+		MissingWith  any          `json:"missingWith" validation:"missingWithAny:Sibling,Other"`
+		RequiredWith any          `json:"requiredWith" validation:"requiredWith:Sibling,Other"`
+		Sibling      any          `json:"sibling"`
+		Other        any          `json:"other"`
+		Group1       any          `json:"group1" validation:"requireOneInGroup:Group"`
+		Group2       any          `json:"group2" validation:"requireOneInGroup:Group"`
+		Date         any          `json:"date" validation:"date"`
+		DateObject   any          `json:"dateObject" validation:"date"`
+		Rfc3339      any          `json:"rfc3339" validation:"rfc3339"`
+		Array        any          `json:"array" validation:"array"`
+		Object       any          `json:"object" validation:"object"`
+		MissingKeys  any          `json:"missingKeys" validation:"objectMissingKeys:a,b"`
+		String       any          `json:"string" validation:"string"`
+		Int          any          `json:"int" validation:"int"`
+		Int8         any          `json:"int8" validation:"int:8"`
+		Uint16       any          `json:"uint16" validation:"uint:16"`
+		Float        any          `json:"float" validation:"float"`
+		Bool         any          `json:"bool" validation:"bool"`
+		In           any          `json:"in" validation:"in:DKK,EUR"`
+		InNull       any          `json:"inNull" validation:"nullable|in:DKK,EUR"`
+		NotIn        any          `json:"notIn" validation:"notIn:DKK,EUR"`
+		Currency     any          `json:"currency" validation:"alpha3Currency"`
+		Country      any          `json:"country" validation:"alpha2Country"`
+		Uuid         any          `json:"uuid" validation:"uuid"`
+		ZeroableUuid any          `json:"zeroableUuid" validation:"zeroableUuid"`
+		Regex        any          `json:"regex" validation:"regex:^a{1,2}$"`
+		Between      any          `json:"between" validation:"between:1,2"`
+		Phone        any          `json:"phone" validation:"phoneNumberE164"`
+		Min          any          `json:"min" validation:"min:1"`
+		Max          any          `json:"max" validation:"max:1"`
+		MaxSize      any          `json:"maxSize" validation:"maxSize:2"`
+		Url          any          `json:"url" validation:"url"`
+		Localhost    any          `json:"localhost" validation:"url"`
+		Ip           any          `json:"ip" validation:"ip"`
+		Email        any          `json:"email" validation:"email"`
+		Json         any          `json:"json" validation:"json"`
+		MapKeys      map[int]bool `json:"mapKeys"`
+	}
+
+	jsonString := []byte(`{
+		"required": null, "len": "ab", "lenMin": "ab", "lenMax": "ab", "lenBetween": [1],
+		"missingIf": 1, "missingWith": 1, "sibling": 123, "other": 1, "group1": 1, "group2": 2,
+		"date": "yesterday", "dateObject": {}, "rfc3339": "now", "array": 1, "object": 1, "missingKeys": {"a": 1},
+		"string": 1, "int": 1.5, "int8": 300, "uint16": -1, "float": "1", "bool": 1,
+		"in": "SEK", "inNull": null, "notIn": "DKK", "currency": {}, "country": ["DK"], "uuid": "00000000-0000-0000-0000-000000000000", "zeroableUuid": "x",
+		"regex": "aaa", "between": 3, "phone": 45, "min": 0, "max": 2, "maxSize": "abcdef",
+		"url": "ftp://x", "localhost": "http://localhost", "ip": "x", "email": "x", "json": "{",
+		"mapKeys": {"a": true}
+	}`)
+
+	messages := func(jsonString []byte, target any, options ...JsonValidator.Option) map[string][]string {
+		var errorBag *JsonValidator.ErrorBag
+		_ = errors.As(JsonValidator.New().Validate(jsonString, target, options...), &errorBag)
+		require.NotNil(t, errorBag)
+
+		return errorBag.Errors
+	}
+
+	typeJson := []byte(`{"amount": 1, "currency": "DKK", "count": 300}`)
+	structureJson := []byte(`{"amount": 1, "currency": "DKK", "unknown": 1, "amount": 1}`)
+	structureOptions := []JsonValidator.Option{JsonValidator.WithStrictMode(), JsonValidator.WithDuplicateKeyDetection()}
+
+	// Act
+	translated := messages(jsonString, &englishPayload{}, JsonValidator.WithLocale("en"))
+	translatedType := messages(typeJson, &messageTestPayment{}, JsonValidator.WithLocale("en"))
+	translatedStructure := messages(structureJson, &messageTestPayment{}, append(structureOptions, JsonValidator.WithLocale("en"))...)
+
+	// Assert
+	require.Equal(t, messages(jsonString, &englishPayload{}), translated)
+	require.Equal(t, messages(typeJson, &messageTestPayment{}), translatedType)
+	require.Equal(t, messages(structureJson, &messageTestPayment{}, structureOptions...), translatedStructure)
+	require.Equal(t, []string{"[lenMin]: Length must be longer than 5 - Actual length: 2"}, translated["lenMin"])
+	require.Equal(t, []string{"[date]: Must be a valid YYYY-MM-DD date formatted string - Got: [yesterday]"}, translated["date"])
+	require.Equal(t, []string{"[int]: Must be an integer between -128 and 127"}, translated["int8"])
+	require.Len(t, translatedStructure, 2)
+}
@@ -0,0 +1,122 @@
+package Tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+type pathTestItem struct {
+	Amount int `json:"amount" validation:"required|int"`
+}
+
+type pathTestOrder struct {
+	Items    []pathTestItem    `json:"items" validation:"required|array"`
+	Metadata map[string]string `json:"metadata" validation:"object|dive|string"`
+	Count    uint8             `json:"count"`
+}
+
+func Test_it_formats_paths_with_the_path_formatter(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter     JsonValidator.PathFormatter
+		expectedPaths []string
+	}{
+		{nil, []string{"items.1.amount", "metadata.a.b", "metadata.c/d", "metadata.e~f", "metadata.it's"}},
+		{JsonValidator.DottedPath, []string{"items.1.amount", "metadata.a.b", "metadata.c/d", "metadata.e~f", "metadata.it's"}},
+		{JsonValidator.JsonPointer, []string{"/items/1/amount", "/metadata/a.b", "/metadata/c~1d", "/metadata/e~0f", "/metadata/it's"}},
+		{JsonValidator.JsonPath, []string{"$.items[1].amount", "$.metadata['a.b']", "$.metadata['c/d']", "$.metadata['e~f']", `$.metadata['it\'s']`}},
+	}
+
+	jsonString := []byte(`{"items": [{"amount": 1}, {"amount": "1"}], "metadata": {"a.b": 1, "c/d": 2, "e~f": 3, "it's": 4}}`)
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data pathTestOrder
+			err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithPathFormatter(testCase.formatter))
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.Equal(t, testCase.expectedPaths, errorBag.GetPaths())
+		})
+	}
+}
+
+func Test_it_formats_paths_of_unmarshal_and_duplicate_key_errors(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString   string
+		formatter    JsonValidator.PathFormatter
+		expectedPath string
+	}{
+		{`{"items": [], "count": 300}`, JsonValidator.JsonPointer, "/count"},
+		{`{"items": [], "count": 300}`, JsonValidator.JsonPath, "$.count"},
+		{`{"items": [{"amount": 1, "amount": 2}]}`, JsonValidator.JsonPointer, "/items/0/amount"},
+		{`{"items": [{"amount": 1, "amount": 2}]}`, JsonValidator.JsonPath, "$.items[0].amount"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data pathTestOrder
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data, JsonValidator.WithPathFormatter(testCase.formatter), JsonValidator.WithDuplicateKeyDetection())
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.Equal(t, []string{testCase.expectedPath}, errorBag.GetPaths())
+		})
+	}
+}
+
+func Test_it_formats_the_path_of_the_top_level_value(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter    JsonValidator.PathFormatter
+		expectedPath string
+	}{
+		{JsonValidator.DottedPath, ""},
+		{JsonValidator.JsonPointer, ""},
+		{JsonValidator.JsonPath, "$"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+			validator := JsonValidator.New(JsonValidator.WithPathFormatter(testCase.formatter))
+
+			// Act
+			var data []pathTestItem
+			err := validator.Validate([]byte(`[]`), &data, JsonValidator.WithRootRules("required|array|lenMin:1"))
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.Equal(t, []string{testCase.expectedPath}, errorBag.GetPaths())
+		})
+	}
+}
+
+func Test_it_formats_paths_of_streamed_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`[{"amount": 1}, {}]`)
+
+	// Act
+	var data []pathTestItem
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data, JsonValidator.WithPathFormatter(JsonValidator.JsonPointer))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"/1/amount"}, errorBag.GetPaths())
+}
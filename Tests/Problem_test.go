@@ -0,0 +1,155 @@
+package Tests
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+type problemTestPayment struct {
+	Amount   int    `json:"amount" validation:"required|int|min:1"`
+	Currency string `json:"currency" validation:"required|string"`
+}
+
+func Test_it_renders_failed_validation_as_problem_details(t *testing.T) {
+	// Arrange
+	var data problemTestPayment
+	err := JsonValidator.New().Validate([]byte(`{"amount": 0}`), &data)
+	recorder := httptest.NewRecorder()
+
+	// Act
+	problem := JsonValidator.NewProblem(err, JsonValidator.WithProblemType("https://example.com/problems/validation"), JsonValidator.WithProblemInstance("/requests/42"))
+	writeErr := problem.Write(recorder)
+
+	// Assert
+	require.NoError(t, writeErr)
+	require.Equal(t, http.StatusUnprocessableEntity, recorder.Code)
+	require.Equal(t, "application/problem+json", recorder.Header().Get("Content-Type"))
+	require.JSONEq(t, `{
+		"type": "https://example.com/problems/validation",
+		"title": "Unprocessable Entity",
+		"status": 422,
+		"detail": "The json failed validation",
+		"instance": "/requests/42",
+		"errors": [
+			{"path": "amount", "rule": "min", "params": ["1"], "message": "Must be a number greater than or equal to 1", "category": "value"},
+			{"path": "currency", "rule": "required", "message": "Is a required non-nullable field", "category": "presence"}
+		],
+		"invalid-params": [
+			{"name": "amount", "reason": "Must be a number greater than or equal to 1"},
+			{"name": "currency", "reason": "Is a required non-nullable field"}
+		]
+	}`, recorder.Body.String())
+}
+
+func Test_it_renders_the_status_of_the_error_category(t *testing.T) {
+	// Setup
+	cases := []struct {
+		err            error
+		expectedStatus int
+		expectDetail   bool
+	}{
+		{JsonValidator.New().Validate([]byte(`{"amount": `), &problemTestPayment{}), http.StatusBadRequest, true},
+		{JsonValidator.ErrBodyTooLarge, http.StatusRequestEntityTooLarge, true},
+		{fmt.Errorf("%w: %w", JsonValidator.ErrValidationFailed, errors.New("custom unmarshaler")), http.StatusUnprocessableEntity, true},
+		{JsonValidator.New().Validate([]byte(`{"amount": 1, "currency": "DKK"}`), problemTestPayment{}), http.StatusInternalServerError, false},
+		{errors.New("unknown"), http.StatusInternalServerError, false},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Act
+			problem := JsonValidator.NewProblem(testCase.err)
+
+			// Assert
+			require.Equal(t, testCase.expectedStatus, problem.Status)
+			require.Equal(t, http.StatusText(testCase.expectedStatus), problem.Title)
+			require.Equal(t, "about:blank", problem.Type)
+			require.Equal(t, testCase.expectDetail, problem.Detail != "")
+			require.Empty(t, problem.Errors)
+		})
+	}
+}
+
+func Test_it_parses_problem_details_into_an_error_bag(t *testing.T) {
+	// Arrange
+	var data problemTestPayment
+	err := JsonValidator.New().Validate([]byte(`{"amount": 0}`), &data)
+	jsonBytes, _ := json.Marshal(JsonValidator.NewProblem(err))
+	var expected *JsonValidator.ErrorBag
+	_ = errors.As(err, &expected)
+
+	// Act
+	problem, parseErr := JsonValidator.ParseProblem(jsonBytes)
+
+	// Assert
+	require.NoError(t, parseErr)
+	require.Equal(t, http.StatusUnprocessableEntity, problem.Status)
+	require.Equal(t, expected.Errors, problem.ErrorBag().Errors)
+	require.Equal(t, expected.GetPaths(), problem.ErrorBag().GetPaths())
+	require.Equal(t, JsonValidator.PresenceCategory, problem.ErrorBag().GetFieldErrorsForKey("currency")[0].Category)
+	require.ErrorIs(t, problem.ErrorBag(), JsonValidator.ErrValidationFailed)
+}
+
+func Test_it_parses_the_paths_of_problem_details_like_the_error_bag(t *testing.T) {
+	// Setup
+	cases := []struct {
+		formatter JsonValidator.PathFormatter
+	}{
+		{JsonValidator.DottedPath},
+		{JsonValidator.JsonPointer},
+		{JsonValidator.JsonPath},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var data []problemTestPayment
+			err := JsonValidator.New().Validate([]byte(`[{"amount": 1, "currency": "DKK"}, {"amount": 0, "currency": "DKK"}]`), &data, JsonValidator.WithPathFormatter(testCase.formatter))
+			jsonBytes, _ := json.Marshal(JsonValidator.NewProblem(err))
+			var expected *JsonValidator.ErrorBag
+			_ = errors.As(err, &expected)
+
+			// Act
+			problem, _ := JsonValidator.ParseProblem(jsonBytes)
+			errorBag := problem.ErrorBag()
+
+			// Assert
+			require.Equal(t, expected.GetFieldErrors()[0].Segments, errorBag.GetFieldErrors()[0].Segments)
+			require.Equal(t, expected.GetPaths(), errorBag.WithPrefix("").GetPaths())
+		})
+	}
+}
+
+func Test_it_round_trips_problem_details(t *testing.T) {
+	// Arrange
+	var data problemTestPayment
+	err := JsonValidator.New().Validate([]byte(`{"amount": 0}`), &data)
+	jsonBytes, _ := json.Marshal(JsonValidator.NewProblem(err))
+
+	// Act
+	problem, parseErr := JsonValidator.ParseProblem(jsonBytes)
+	roundTripped, _ := json.Marshal(problem)
+
+	// Assert
+	require.NoError(t, parseErr)
+	require.JSONEq(t, string(jsonBytes), string(roundTripped))
+	require.Equal(t, 2, problem.ErrorBag().CountErrors())
+}
+
+func Test_it_parses_invalid_params_of_problem_details(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"type": "https://example.net/validation-error", "title": "Your request parameters didn't validate.", "invalid-params": [{"name": "age", "reason": "must be a positive integer"}]}`)
+
+	// Act
+	problem, err := JsonValidator.ParseProblem(jsonString)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"must be a positive integer"}, problem.ErrorBag().GetErrorsForKey("age"))
+}
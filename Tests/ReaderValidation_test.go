@@ -0,0 +1,289 @@
+package Tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+type readerTestPayment struct {
+	Amount   int    `json:"amount" validation:"required|integer|min:1"`
+	Currency string `json:"currency" validation:"required|string|len:3"`
+}
+
+func Test_it_can_validate_objects_from_a_reader(t *testing.T) {
+	// Arrange
+	reader := strings.NewReader(`{"amount": 10, "currency": "DKK"}`)
+
+	// Act
+	var data readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, readerTestPayment{Amount: 10, Currency: "DKK"}, data)
+}
+
+func Test_it_returns_validation_errors_for_objects_from_a_reader(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`{"amount": 0}`)
+
+	// Act
+	var data readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("currency", "required"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_can_stream_top_level_arrays_from_a_reader(t *testing.T) {
+	// Arrange
+	reader := strings.NewReader(` [{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}] `)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []readerTestPayment{{1, "DKK"}, {2, "SEK"}}, data)
+}
+
+func Test_it_reports_errors_of_all_streamed_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`[{"amount": 0, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}, {"amount": 3, "currency": "SEKK"}]`)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("0.amount", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("2.currency", "len"))
+	require.Equal(t, 2, errorBag.CountErrors())
+	require.Nil(t, data)
+}
+
+func Test_it_applies_entry_rules_to_streamed_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`[{"amount": 1, "currency": "DKK"}, null]`)
+
+	// Act
+	var data []*readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data, JsonValidator.WithRootRules("dive|required"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("1", "required"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_applies_root_rules_to_arrays_from_a_reader(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`[{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}]`)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data, JsonValidator.WithRootRules("array|lenMax:1"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("", "lenMax"))
+}
+
+func Test_it_rejects_bodies_larger_than_the_maximum_size(t *testing.T) {
+	// Arrange
+	reader := strings.NewReader(`[{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}]`)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data, JsonValidator.WithMaxBodySize(40))
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrBodyTooLarge)
+}
+
+func Test_it_validates_objects_holding_arrays_from_a_reader_like_validate(t *testing.T) {
+	// Arrange
+	type readerTestBatch struct {
+		Payments []readerTestPayment `json:"payments" validation:"required|array|lenMax:2"`
+	}
+
+	body := `{"payments": [{"amount": 1, "currency": "DKK"}, {"amount": 0}, {"amount": 3, "currency": "SEK"}]}`
+	var expected *JsonValidator.ErrorBag
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data readerTestBatch
+	_ = errors.As(JsonValidator.New().Validate([]byte(body), &data, JsonValidator.WithSourcePositions()), &expected)
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(body), &data, JsonValidator.WithSourcePositions())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.True(t, errorBag.HasFailedKeyAndRule("payments", "lenMax"))
+	require.Equal(t, expected.GetFieldErrors(), errorBag.GetFieldErrors())
+}
+
+func Test_it_streams_arrays_in_fields_of_objects_from_a_reader(t *testing.T) {
+	// Arrange
+	type readerTestBatch struct {
+		Reference string              `json:"reference" validation:"required|string"`
+		Payments  []readerTestPayment `json:"payments" validation:"required|array"`
+	}
+
+	valid := `{"payments": [{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}], "reference": "batch-1"}`
+	invalid := `{"payments": [{"amount": 1, "currency": "DKK"}, {"amount": 0, "currency": "SEK"}]}`
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data readerTestBatch
+	validErr := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(valid), &data)
+	var invalidData readerTestBatch
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(invalid), &invalidData)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, validErr)
+	require.Equal(t, readerTestBatch{Reference: "batch-1", Payments: []readerTestPayment{{1, "DKK"}, {2, "SEK"}}}, data)
+	require.NotNil(t, errorBag, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("payments.1.amount", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("reference", "required"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_populates_the_members_of_streamed_objects_like_validate(t *testing.T) {
+	// Arrange
+	type readerTestBatch struct {
+		Metadata json.RawMessage     `json:"metadata"`
+		Note     string              `json:"note"`
+		Payments []readerTestPayment `json:"payments" validation:"required|array"`
+	}
+
+	body := `{"metadata": {"source": "<batch>"}, "payments": [{"amount": 1, "currency": "DKK"}], "NOTE": "a", "Payments": [{"amount": 2, "currency": "SEK"}]}`
+
+	// Act
+	var expected readerTestBatch
+	expectedErr := JsonValidator.New().Validate([]byte(body), &expected)
+	var data readerTestBatch
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(body), &data)
+
+	// Assert
+	require.NoError(t, expectedErr)
+	require.NoError(t, err)
+	require.Equal(t, `{"source": "<batch>"}`, string(data.Metadata))
+	require.Equal(t, []readerTestPayment{{2, "SEK"}}, data.Payments)
+	require.Equal(t, expected, data)
+}
+
+func Test_it_keeps_streaming_arrays_with_implicit_type_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	reader := strings.NewReader(`[{"amount": 0, "currency": "DKK"}, {"amount": 1, "currency": "DKK"}] garbage`)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).ValidateReader(context.Background(), reader, &data, JsonValidator.WithMaxErrors(1))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("0.amount", "min"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_object_bodies_larger_than_the_maximum_size(t *testing.T) {
+	// Arrange
+	reader := strings.NewReader(`{"payments": [{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}]}`)
+
+	// Act
+	var data struct {
+		Payments []readerTestPayment `json:"payments"`
+	}
+	err := JsonValidator.New().ValidateReader(context.Background(), reader, &data, JsonValidator.WithMaxBodySize(40))
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrBodyTooLarge)
+}
+
+func Test_it_accepts_bodies_of_exactly_the_maximum_size(t *testing.T) {
+	// Arrange
+	body := `{"amount": 10, "currency": "DKK"}`
+
+	// Act
+	var data readerTestPayment
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(body), &data, JsonValidator.WithMaxBodySize(int64(len(body))))
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func Test_it_rejects_invalid_json_from_a_reader(t *testing.T) {
+	// Setup
+	cases := []struct {
+		body        string
+		expectedErr error
+	}{
+		{``, JsonValidator.ErrEmptyJson},
+		{`   `, JsonValidator.ErrEmptyJson},
+		{`{"amount": 10`, io.ErrUnexpectedEOF},
+		{`{"amount": 10} {}`, JsonValidator.ErrTrailingData},
+		{`[{"amount": 1, "currency": "DKK"}`, io.ErrUnexpectedEOF},
+		{`[{"amount": 1, "currency": "DKK"}] garbage`, JsonValidator.ErrTrailingData},
+	}
+
+	for _, testCase := range cases {
+		// Arrange
+		var syntaxError *JsonValidator.SyntaxError
+
+		// Act
+		var data []readerTestPayment
+		err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(testCase.body), &data)
+
+		// Assert
+		require.True(t, errors.As(err, &syntaxError), testCase.body)
+		require.ErrorIs(t, err, testCase.expectedErr, testCase.body)
+	}
+}
+
+func Test_it_stops_streaming_when_the_context_is_cancelled(t *testing.T) {
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	reader := strings.NewReader(`[{"amount": 1, "currency": "DKK"}]`)
+
+	// Act
+	var data []readerTestPayment
+	err := JsonValidator.New().ValidateReader(ctx, reader, &data)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func Test_it_can_validate_from_a_reader_using_typed_validators(t *testing.T) {
+	// Arrange
+	typed, _ := JsonValidator.ValidatorFor[[]readerTestPayment]()
+
+	// Act
+	data, err := typed.ValidateReader(context.Background(), strings.NewReader(`[{"amount": 1, "currency": "DKK"}]`))
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, data, 1)
+}
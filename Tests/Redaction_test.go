@@ -0,0 +1,299 @@
+package Tests
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+type redactionTestCard struct {
+	Number string `json:"number" validation:"required|string|regex:^[0-9]{16}$"`
+	Cvv    int    `json:"cvv"`
+	Expiry string `json:"expiry" validation:"date"`
+}
+
+type redactionTestPayment struct {
+	Pan      string            `json:"pan" validation:"sensitive|required|in:4111111111111111"`
+	Card     redactionTestCard `json:"card" validation:"sensitive|strict"`
+	Secrets  []string          `json:"secrets" validation:"sensitive|array|dive|in:a,b"`
+	Currency string            `json:"currency" validation:"alpha3Currency"`
+}
+
+func Test_it_redacts_sensitive_values_from_errors(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString      string
+		path            string
+		expectedMessage string
+		expectedValue   any
+	}{
+		{`{"pan": "4000123412341234"}`, "pan", "Value must be in set: [4111111111111111] - [<redacted>] given", "<redacted>"},
+		{`{"pan": 4000123412341234}`, "pan", "Value must be in set: [4111111111111111] - [<redacted>] given", "<redacted>"},
+		{`{"pan": "4111111111111111", "card": {"number": "4000-1234"}}`, "card.number", "Must be a string matching regex: ^[0-9]{16}$", "<redacted>"},
+		{`{"pan": "4111111111111111", "card": {"number": "4000123412341234", "expiry": "1999-13-45"}}`, "card.expiry", "Must be a valid YYYY-MM-DD date formatted string - Got: [<redacted>]", "<redacted>"},
+		{`{"pan": "4111111111111111", "card": {"number": "4000123412341234", "pin": {"code": 1234}}}`, "card.pin", "Is not a known field", "<redacted>"},
+		{`{"pan": "4111111111111111", "secrets": ["a", "c"]}`, "secrets.1", "Value must be in set: [a, b] - [<redacted>] given", "<redacted>"},
+		{`{"pan": "4111111111111111", "currency": "ABC"}`, "currency", "Must be a valid alpha-3 currency code - [ABC] given", "ABC"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data redactionTestPayment
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			fieldErrors := errorBag.GetFieldErrorsForKey(testCase.path)
+			require.Len(t, fieldErrors, 1)
+			require.Equal(t, testCase.expectedMessage, fieldErrors[0].Message)
+			require.Equal(t, testCase.expectedValue, fieldErrors[0].Value)
+		})
+	}
+}
+
+func Test_it_redacts_sensitive_values_with_the_redaction_policy(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"pan": "4000123412341234"}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data redactionTestPayment
+	err := JsonValidator.New(JsonValidator.WithRedactionPolicy(JsonValidator.RedactLastFour)).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[in]: Value must be in set: [4111111111111111] - [************1234] given"}, errorBag.GetErrorsForKey("pan"))
+	require.Equal(t, "************1234", errorBag.GetFieldErrorsForKey("pan")[0].Value)
+}
+
+func Test_it_masks_every_character_of_short_values_with_the_last_four_policy(t *testing.T) {
+	// Setup
+	cases := []struct {
+		value    string
+		expected string
+	}{
+		{"", ""},
+		{"123", "***"},
+		{"1234", "****"},
+		{"12345", "*2345"},
+		{"æøåæøå", "**åæøå"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Act
+			redacted := JsonValidator.RedactLastFour(testCase.value)
+
+			// Assert
+			require.Equal(t, testCase.expected, redacted)
+		})
+	}
+}
+
+func Test_it_redacts_sensitive_values_of_unmarshal_errors(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"pan": "4111111111111111", "card": {"number": "4000123412341234", "cvv": "987"}}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data redactionTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[type]: Must be a value convertible to [int]"}, errorBag.GetErrorsForKey("card.cvv"))
+	require.Equal(t, "<redacted>", errorBag.GetFieldErrorsForKey("card.cvv")[0].Value)
+}
+
+func Test_it_redacts_sensitive_values_from_translated_messages(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"pan": "4000123412341234"}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data redactionTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[in]: Værdien skal være en af: [4111111111111111] - [<redacted>] er givet"}, errorBag.GetErrorsForKey("pan"))
+}
+
+func Test_it_does_not_leak_sensitive_values_through_any_output(t *testing.T) {
+	// Arrange
+	secrets := []string{"4000123412341234", "4000-9876", "1999-13-45", "5555", "topsecret"}
+	jsonString := []byte(`{
+		"pan": "4000123412341234",
+		"card": {"number": "4000-9876", "expiry": "1999-13-45", "pin": 5555},
+		"secrets": ["a", "topsecret"]
+	}`)
+	invalidJsonString := []byte(`{"pan": "4000123412341234" "card": {"number": "4000-9876", "pin": 5555}}`)
+	var errorBag *JsonValidator.ErrorBag
+	var syntaxError *JsonValidator.SyntaxError
+	var readerSyntaxError *JsonValidator.SyntaxError
+
+	// Act
+	var data redactionTestPayment
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithSourcePositions())
+	_ = errors.As(err, &errorBag)
+
+	syntaxErr := JsonValidator.New().Validate(invalidJsonString, &data)
+	_ = errors.As(syntaxErr, &syntaxError)
+	readerSyntaxErr := JsonValidator.New().ValidateReader(context.Background(), bytes.NewReader(invalidJsonString), &data)
+	_ = errors.As(readerSyntaxErr, &readerSyntaxError)
+
+	fieldErrorsJson, _ := json.Marshal(errorBag.GetFieldErrors())
+	errorBagJson, _ := json.Marshal(errorBag)
+	problemJson, _ := json.Marshal(JsonValidator.NewProblem(err))
+	syntaxProblemJson, _ := json.Marshal(JsonValidator.NewProblem(syntaxErr))
+
+	// Assert
+	require.Len(t, errorBag.GetFieldErrors(), 5)
+	require.NotNil(t, syntaxError, syntaxErr)
+	require.NotNil(t, readerSyntaxError, readerSyntaxErr)
+	require.Empty(t, syntaxError.Excerpt)
+	require.Empty(t, readerSyntaxError.Excerpt)
+	require.Equal(t, 1, syntaxError.Line)
+
+	for _, secret := range secrets {
+		require.NotContains(t, err.Error(), secret)
+		require.NotContains(t, fmt.Sprintf("%v", errorBag), secret)
+		require.NotContains(t, string(fieldErrorsJson), secret)
+		require.NotContains(t, string(errorBagJson), secret)
+		require.NotContains(t, string(problemJson), secret)
+		require.NotContains(t, syntaxErr.Error(), secret)
+		require.NotContains(t, readerSyntaxErr.Error(), secret)
+		require.NotContains(t, string(syntaxProblemJson), secret)
+	}
+}
+
+func Test_it_leaves_the_invalid_character_out_of_syntax_errors_of_sensitive_targets(t *testing.T) {
+	// Setup
+	type plainPayment struct {
+		Pan string `json:"pan"`
+	}
+
+	type sensitiveEntries struct {
+		Pans []string `json:"pans" validation:"array|dive|sensitive"`
+	}
+
+	jsonString := []byte(`{"pan": 4000123412341234x}`)
+
+	// Act
+	plainErr := JsonValidator.New().Validate(jsonString, &plainPayment{})
+	sensitiveErr := JsonValidator.New().Validate(jsonString, &redactionTestPayment{})
+	entriesErr := JsonValidator.New().Validate(jsonString, &sensitiveEntries{})
+
+	// Assert
+	require.Equal(t, "invalid json cannot be parsed: invalid character 'x' after object key:value pair at line 1, column 25", plainErr.Error())
+	require.Equal(t, "invalid json cannot be parsed: invalid character after object key:value pair at line 1, column 25", sensitiveErr.Error())
+	require.Equal(t, sensitiveErr.Error(), entriesErr.Error())
+}
+
+func Test_it_redacts_sensitive_values_with_implicit_type_rules(t *testing.T) {
+	// Arrange
+	type implicitPayment struct {
+		Pan string `json:"pan" validation:"sensitive|required|in:4111"`
+	}
+
+	jsonString := []byte(`{"pan": "4242424242424242"}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data implicitPayment
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[in]: Value must be in set: [4111] - [<redacted>] given"}, errorBag.GetErrorsForKey("pan"))
+	require.NotContains(t, err.Error(), "4242424242424242")
+}
+
+func Test_it_redacts_sensitive_map_keys(t *testing.T) {
+	// Setup
+	cases := []struct {
+		validator *JsonValidator.Validator
+	}{
+		{JsonValidator.New()},
+		{JsonValidator.New(JsonValidator.WithImplicitTypeRules())},
+	}
+
+	type keyedPayment struct {
+		Cards map[int]string `json:"cards" validation:"object|dive|keys|sensitive|in:1,2|endkeys|string"`
+	}
+
+	jsonString := []byte(`{"cards": {"4242424242424242": "visa"}}`)
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data keyedPayment
+			err := testCase.validator.Validate(jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.True(t, errorBag.IsInvalid())
+
+			// The key is a part of the path, which is never redacted, but neither the value nor the message mention it
+			for _, fieldError := range errorBag.GetFieldErrors() {
+				require.Equal(t, "<redacted>", fieldError.Value)
+				require.NotContains(t, fieldError.Message, "4242424242424242")
+			}
+		})
+	}
+}
+
+func Test_it_reports_syntax_errors_of_recursive_targets(t *testing.T) {
+	// Setup
+	type plainNode struct {
+		Name string      `json:"name"`
+		Kids []plainNode `json:"kids"`
+	}
+
+	type sensitiveNode struct {
+		Name   string          `json:"name"`
+		Secret string          `json:"secret" validation:"sensitive"`
+		Kids   []sensitiveNode `json:"kids" validation:"array"`
+	}
+
+	jsonString := []byte(`{"name": "a", "secret": "topsecret",`)
+
+	cases := []struct {
+		target          any
+		expectedExcerpt bool
+	}{
+		{&plainNode{}, true},
+		{&sensitiveNode{}, false},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var syntaxError *JsonValidator.SyntaxError
+			var readerSyntaxError *JsonValidator.SyntaxError
+
+			// Act
+			err := JsonValidator.New().Validate(jsonString, testCase.target)
+			readerErr := JsonValidator.New().ValidateReader(context.Background(), bytes.NewReader(jsonString), testCase.target)
+
+			// Assert
+			require.True(t, errors.As(err, &syntaxError), err)
+			require.True(t, errors.As(readerErr, &readerSyntaxError), readerErr)
+			require.Equal(t, testCase.expectedExcerpt, strings.Contains(syntaxError.Excerpt, "topsecret"))
+			require.Equal(t, testCase.expectedExcerpt, strings.Contains(readerSyntaxError.Excerpt, "topsecret"))
+		})
+	}
+}
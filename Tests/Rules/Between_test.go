@@ -20,6 +20,9 @@ func Test_it_can_validate_using_between_rule(t *testing.T) {
 		{[]byte(`{"Data": 5.123}`), false},
 		{[]byte(`{"Data": 9.99}`), false},
 		{[]byte(`{"Data": 10.00}`), false},
+		{[]byte(`{"Data": 1e1}`), false},
+		{[]byte(`{"Data": 10.000000000000000001}`), true},
+		{[]byte(`{"Data": "7"}`), true},
 		{[]byte(`{"Data": [1,2,3]}`), true},
 		{[]byte(`{"Data": {}}`), true},
 		{[]byte(`{"Data": "hello world"}`), true},
@@ -56,3 +59,42 @@ func Test_it_can_validate_using_between_rule(t *testing.T) {
 		})
 	}
 }
+
+func Test_it_compares_numbers_beyond_float_precision_exactly(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 9007199254740993}`), false},
+		{[]byte(`{"Data": 9007199254740992}`), true},
+		{[]byte(`{"Data": 9007199254740994}`), true},
+		{[]byte(`{"Data": 9223372036854775807}`), true},
+	}
+
+	type testData struct {
+		Data int64 `validation:"between:9007199254740993,9007199254740993"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "between"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil)
+				require.Equal(t, int64(9007199254740993), data.Data)
+			}
+		})
+	}
+}
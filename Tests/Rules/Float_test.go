@@ -1,6 +1,7 @@
 package Rules
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"github.com/epay-technology/json-validator-go/JsonValidator"
@@ -16,6 +17,7 @@ func Test_it_can_validate_using_float_rule(t *testing.T) {
 	}{
 		{[]byte(`{"Data": 123}`), false},
 		{[]byte(`{"Data": 123.45}`), false},
+		{[]byte(`{"Data": "123.45"}`), true},
 		{[]byte(`{"Data": true}`), true},
 		{[]byte(`{"Data": "hello world"}`), true},
 		{[]byte(`{"Data": {}}`), true},
@@ -49,3 +51,18 @@ func Test_it_can_validate_using_float_rule(t *testing.T) {
 		})
 	}
 }
+
+func Test_it_accepts_floats_beyond_the_range_of_float64(t *testing.T) {
+	// Arrange
+	type testData struct {
+		Data json.Number `validation:"float"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate([]byte(`{"Data": 1e400}`), &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, json.Number("1e400"), data.Data)
+}
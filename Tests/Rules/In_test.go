@@ -17,6 +17,9 @@ func Test_it_can_validate_using_in_rule(t *testing.T) {
 	}{
 		{[]byte(`{"Data": 123}`), false},
 		{[]byte(`{"Data": 45.12}`), false},
+		{[]byte(`{"Data": 45.120}`), false},
+		{[]byte(`{"Data": 123.0}`), false},
+		{[]byte(`{"Data": 1.23e2}`), false},
 		{[]byte(`{"Data": "abc"}`), false},
 		{[]byte(`{"Data": false}`), false},
 		{[]byte(`{"Data": ""}`), false},
@@ -72,6 +75,10 @@ func Test_it_prints_the_actual_value_for_the_in_rule(t *testing.T) {
 		{[]byte(`{"Data": "hello world"}`), "[hello world] given"},
 		{[]byte(`{"Data": 12}`), "[12] given"},
 		{[]byte(`{"Data": 12.45}`), "[12.45] given"},
+		{[]byte(`{"Data": 10}`), "[10] given"},
+		{[]byte(`{"Data": 0.50}`), "[0.5] given"},
+		{[]byte(`{"Data": 1e3}`), "[1000] given"},
+		{[]byte(`{"Data": 9007199254740993}`), "[9007199254740993] given"},
 		{[]byte(`{"Data": {}}`), "Object given"},
 		{[]byte(`{"Data": [1,2,3]}`), "Array given"},
 		{[]byte(`{"Data": null}`), "[NULL] given"},
@@ -15,6 +15,15 @@ func Test_it_can_validate_using_integer_rule(t *testing.T) {
 		shouldFail bool
 	}{
 		{[]byte(`{"Data": 123}`), false},
+		{[]byte(`{"Data": -123}`), false},
+		{[]byte(`{"Data": 1.0}`), false},
+		{[]byte(`{"Data": 1e2}`), false},
+		{[]byte(`{"Data": 9007199254740993}`), false},
+		{[]byte(`{"Data": 18446744073709551616}`), false},
+		{[]byte(`{"Data": 1.5}`), true},
+		{[]byte(`{"Data": 9007199254740993.5}`), true},
+		{[]byte(`{"Data": 1e-2}`), true},
+		{[]byte(`{"Data": 1e1000000000}`), true},
 		{[]byte(`{"Data": true}`), true},
 		{[]byte(`{"Data": "hello world"}`), true},
 		{[]byte(`{"Data": {}}`), true},
@@ -48,3 +57,86 @@ func Test_it_can_validate_using_integer_rule(t *testing.T) {
 		})
 	}
 }
+
+func Test_it_can_validate_using_integer_rule_with_a_size_in_bits(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 0}`), false},
+		{[]byte(`{"Data": 127}`), false},
+		{[]byte(`{"Data": -128}`), false},
+		{[]byte(`{"Data": 127.0}`), false},
+		{[]byte(`{"Data": 128}`), true},
+		{[]byte(`{"Data": -129}`), true},
+		{[]byte(`{"Data": 1.5}`), true},
+		{[]byte(`{"Data": "1"}`), true},
+		{[]byte(`{"Data": null}`), true},
+	}
+
+	type testData struct {
+		Data any `validation:"int:8"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "int"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
+
+func Test_it_can_validate_using_integer_rule_with_64_bits(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 9223372036854775807}`), false},
+		{[]byte(`{"Data": -9223372036854775808}`), false},
+		{[]byte(`{"Data": 9223372036854775808}`), true},
+		{[]byte(`{"Data": -9223372036854775809}`), true},
+	}
+
+	type testData struct {
+		Data any `validation:"int:64"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "int"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
@@ -18,6 +18,8 @@ func Test_it_can_validate_using_max_rule(t *testing.T) {
 		{[]byte(`{"Data": 8}`), false},
 		{[]byte(`{"Data": 5.123}`), false},
 		{[]byte(`{"Data": 4.99}`), false},
+		{[]byte(`{"Data": -18446744073709551616}`), false},
+		{[]byte(`{"Data": 8.00000000000000000001}`), true},
 		{[]byte(`{"Data": 10}`), true},
 		{[]byte(`{"Data": 9.99}`), true},
 		{[]byte(`{"Data": 10.00}`), true},
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/epay-technology/json-validator-go/JsonValidator"
 	"github.com/stretchr/testify/require"
+	"strings"
 	"testing"
 )
 
@@ -22,6 +23,8 @@ func Test_it_can_validate_using_min_rule(t *testing.T) {
 		{[]byte(`{"Data": 10.00}`), false},
 		{[]byte(`{"Data": 123}`), false},
 		{[]byte(`{"Data": 10.01}`), false},
+		{[]byte(`{"Data": 18446744073709551616}`), false},
+		{[]byte(`{"Data": 4.99999999999999999999}`), true},
 		{[]byte(`{"Data": [1,2,3]}`), true},
 		{[]byte(`{"Data": {}}`), true},
 		{[]byte(`{"Data": "hello world"}`), true},
@@ -56,3 +59,22 @@ func Test_it_can_validate_using_min_rule(t *testing.T) {
 		})
 	}
 }
+
+func Test_it_fails_min_rule_for_numbers_with_too_many_digits(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"Data": ` + strings.Repeat("9", 100000) + `}`)
+	type testData struct {
+		Data any `validation:"min:0"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("Data", "min"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
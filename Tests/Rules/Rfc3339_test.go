@@ -0,0 +1,57 @@
+package Rules
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_it_can_validate_using_rfc3339_rule(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 123}`), true},
+		{[]byte(`{"Data": true}`), true},
+		{[]byte(`{"Data": {}}`), true},
+		{[]byte(`{"Data": [1,2,3]}`), true},
+		{[]byte(`{"Data": null}`), true},
+		{[]byte(`{"Data": ""}`), true},
+		{[]byte(`{"Data": "2024-01-01"}`), true},
+		{[]byte(`{"Data": "2024-01-01T12:00:00"}`), true},
+		{[]byte(`{"Data": "2024-13-01T12:00:00Z"}`), true},
+		{[]byte(`{"Data": "2024-01-01 12:00:00Z"}`), true},
+		{[]byte(`{"Data": "2024-01-01T12:00:00Z"}`), false},
+		{[]byte(`{"Data": "2024-01-01T12:00:00.123456Z"}`), false},
+		{[]byte(`{"Data": "2024-01-01T12:00:00+02:00"}`), false},
+	}
+
+	type testData struct {
+		Data any `validation:"rfc3339"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "rfc3339"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
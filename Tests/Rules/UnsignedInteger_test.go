@@ -0,0 +1,131 @@
+package Rules
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_it_can_validate_using_unsigned_integer_rule(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 0}`), false},
+		{[]byte(`{"Data": 123}`), false},
+		{[]byte(`{"Data": 1.0}`), false},
+		{[]byte(`{"Data": 18446744073709551616}`), false},
+		{[]byte(`{"Data": -1}`), true},
+		{[]byte(`{"Data": 1.5}`), true},
+		{[]byte(`{"Data": true}`), true},
+		{[]byte(`{"Data": "123"}`), true},
+		{[]byte(`{"Data": {}}`), true},
+		{[]byte(`{"Data": [1,2,3]}`), true},
+		{[]byte(`{"Data": null}`), true},
+	}
+
+	type testData struct {
+		Data any `validation:"uint"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "uint"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
+
+func Test_it_can_validate_using_unsigned_integer_rule_with_a_size_in_bits(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 0}`), false},
+		{[]byte(`{"Data": 255}`), false},
+		{[]byte(`{"Data": 256}`), true},
+		{[]byte(`{"Data": -1}`), true},
+	}
+
+	type testData struct {
+		Data any `validation:"uint:8"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "uint"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
+
+func Test_it_can_validate_using_unsigned_integer_rule_with_64_bits(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		shouldFail bool
+	}{
+		{[]byte(`{"Data": 18446744073709551615}`), false},
+		{[]byte(`{"Data": 18446744073709551616}`), true},
+	}
+
+	type testData struct {
+		Data any `validation:"uint:64"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate(testCase.jsonString, &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.shouldFail {
+				require.True(t, errorBag != nil)
+				require.True(t, errorBag.HasFailedKeyAndRule("Data", "uint"))
+				require.Equal(t, 1, errorBag.CountErrors())
+			} else {
+				require.True(t, errorBag == nil, string(testCase.jsonString))
+				require.Equal(t, 0, errorBag.CountErrors())
+			}
+		})
+	}
+}
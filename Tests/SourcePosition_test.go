@@ -0,0 +1,135 @@
+package Tests
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+type positionTestItem struct {
+	Sku    string `json:"sku" validation:"required|string"`
+	Amount int    `json:"amount" validation:"required|int|min:1"`
+}
+
+type positionTestOrder struct {
+	Reference string             `json:"reference" validation:"required|string"`
+	Items     []positionTestItem `json:"items" validation:"required|array"`
+}
+
+const positionTestJson = `{
+  "reference": 42,
+  "items": [
+    {"sku": "A", "amount": 1},
+    {
+      "sku": "B",
+      "amount": 0
+    },
+    {"amount": 2}
+  ]
+}`
+
+func Test_it_adds_source_positions_to_errors(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data positionTestOrder
+	err := JsonValidator.New().Validate([]byte(positionTestJson), &data, JsonValidator.WithSourcePositions())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, map[string]JsonValidator.SourcePosition{
+		"reference":      {Offset: 17, Line: 2, Column: 16},
+		"items.1.amount": {Offset: 105, Line: 7, Column: 17},
+		"items.2.sku":    {Offset: 118, Line: 9, Column: 5},
+	}, collectPositions(errorBag))
+}
+
+func Test_it_adds_source_positions_to_errors_of_readers(t *testing.T) {
+	// Setup
+	cases := []string{
+		"",
+		"\n\n  ",
+	}
+
+	for i, prefix := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var expectedBag *JsonValidator.ErrorBag
+			var errorBag *JsonValidator.ErrorBag
+			jsonString := prefix + positionTestJson
+
+			// Act
+			var bytesData, readerData positionTestOrder
+			expectedErr := JsonValidator.New().Validate([]byte(jsonString), &bytesData, JsonValidator.WithSourcePositions())
+			err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(jsonString), &readerData, JsonValidator.WithSourcePositions())
+			_ = errors.As(expectedErr, &expectedBag)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.Equal(t, collectPositions(expectedBag), collectPositions(errorBag))
+		})
+	}
+}
+
+func Test_it_adds_source_positions_to_errors_of_streamed_entries(t *testing.T) {
+	// Arrange
+	jsonString := "[\n  {\"sku\": \"A\", \"amount\": 1},\n" + strings.Repeat("  {\"sku\": \"A\", \"amount\": 1},\n", 1000) + "  {\"sku\": \"B\",\n   \"amount\": 0}\n]"
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data []positionTestItem
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(jsonString), &data, JsonValidator.WithSourcePositions())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, map[string]JsonValidator.SourcePosition{
+		"1001.amount": {Offset: int64(len(jsonString) - 4), Line: 1004, Column: 14},
+	}, collectPositions(errorBag))
+}
+
+func Test_it_uses_the_position_of_the_parent_of_missing_values(t *testing.T) {
+	// Arrange
+	jsonString := []byte("{\"items\": [\n  {\"sku\": \"A\"}\n]}")
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data positionTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithSourcePositions())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, map[string]JsonValidator.SourcePosition{
+		"reference":      {Offset: 0, Line: 1, Column: 1},
+		"items.0.amount": {Offset: 14, Line: 2, Column: 3},
+	}, collectPositions(errorBag))
+}
+
+func Test_it_does_not_add_source_positions_by_default(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data positionTestOrder
+	err := JsonValidator.New().Validate([]byte(positionTestJson), &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	for _, fieldError := range errorBag.GetFieldErrors() {
+		require.Nil(t, fieldError.Position)
+	}
+}
+
+func collectPositions(errorBag *JsonValidator.ErrorBag) map[string]JsonValidator.SourcePosition {
+	positions := map[string]JsonValidator.SourcePosition{}
+
+	for _, fieldError := range errorBag.GetFieldErrors() {
+		positions[fieldError.Path] = *fieldError.Position
+	}
+
+	return positions
+}
@@ -0,0 +1,250 @@
+package Structure
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_it_can_validate_valid_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"tags": ["a", "bc"]}`)
+	type testData struct {
+		Tags []string `json:"tags" validation:"required|array|dive|string|lenMax:2"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+	require.Equal(t, []string{"a", "bc"}, data.Tags)
+}
+
+func Test_it_can_validate_invalid_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"tags": ["a", "bc", 3, "def"]}`)
+	type testData struct {
+		Tags []string `json:"tags" validation:"required|array|dive|string|lenMax:2"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("tags.2", "string"))
+	require.True(t, errorBag.HasFailedKeyAndRule("tags.2", "lenMax"))
+	require.True(t, errorBag.HasFailedKeyAndRule("tags.3", "lenMax"))
+	require.Equal(t, 3, errorBag.CountErrors())
+}
+
+func Test_it_does_not_dive_when_the_field_itself_is_invalid(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"tags": ["abc", "def", "ghi"]}`)
+	type testData struct {
+		Tags []string `json:"tags" validation:"required|array|lenMax:2|dive|lenMax:2"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("tags", "lenMax"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_require_non_null_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"tags": ["a", null]}`)
+	type testData struct {
+		Tags []*string `json:"tags" validation:"required|array|dive|required|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("tags.1", "required"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_allow_nullable_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"tags": ["a", null]}`)
+	type testData struct {
+		Tags []*string `json:"tags" validation:"required|array|dive|nullable|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+}
+
+func Test_it_validates_the_fields_of_null_struct_entries(t *testing.T) {
+	// Setup
+	type child struct {
+		ID int `json:"id" validation:"required|integer"`
+	}
+
+	type testData struct {
+		Children []child           `json:"children"`
+		Named    map[string]child  `json:"named"`
+		Nullable []*child          `json:"nullable" validation:"array|dive|nullable"`
+		Pointers map[string]*child `json:"pointers"`
+	}
+
+	cases := []struct {
+		jsonString   string
+		expectedPath string
+	}{
+		{`{"children": [{"id": 1}, null]}`, "children.1.id"},
+		{`{"named": {"a": null}}`, "named.a.id"},
+		{`{"pointers": {"a": null}}`, "pointers.a.id"},
+		{`{"nullable": [null]}`, ""},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			if testCase.expectedPath == "" {
+				require.NoError(t, err)
+				return
+			}
+
+			require.NotNil(t, errorBag, err)
+			require.True(t, errorBag.HasFailedKeyAndRule(testCase.expectedPath, "required"), errorBag.Error())
+			require.Equal(t, 1, errorBag.CountErrors())
+		})
+	}
+}
+
+func Test_it_can_validate_nested_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"matrix": [["a", "b"], ["c", 4], "d"]}`)
+	type testData struct {
+		Matrix [][]string `json:"matrix" validation:"required|dive|array|dive|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("matrix.1.1", "string"))
+	require.True(t, errorBag.HasFailedKeyAndRule("matrix.2", "array"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_map_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"a": "DKK", "b": "WTF"}}`)
+	type testData struct {
+		Metadata map[string]string `json:"metadata" validation:"required|object|dive|alpha3Currency"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.b", "alpha3Currency"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_validates_both_entry_rules_and_entry_sub_fields(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"Child": [{"Data": "ab"}, {"Data": "abc"}, 5]}`)
+	type testDataChild struct {
+		Data string `validation:"len:2"`
+	}
+
+	type testDataParent struct {
+		Child []testDataChild `validation:"required|dive|object"`
+	}
+
+	// Act
+	var data testDataParent
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("Child.1.Data", "len"))
+	require.True(t, errorBag.HasFailedKeyAndRule("Child.2", "object"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_does_not_share_entry_rules_between_fields_of_the_same_type(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"A": ["abc"], "B": ["abc"], "C": ["abc"]}`)
+	type testData struct {
+		A []string `validation:"required"`
+		B []string `validation:"required|dive|len:2"`
+		C []string `validation:"required"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("B.0", "len"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_entry_rules_for_fields_without_entries(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"name": "abc"}`)
+	type testData struct {
+		Name string `json:"name" validation:"dive|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrInvalidSchema)
+}
@@ -0,0 +1,138 @@
+package Structure
+
+import (
+	"context"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func Test_it_accepts_duplicate_keys_by_default(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 1, "amount": 100000}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 100000, data.Amount)
+}
+
+func Test_it_rejects_duplicate_keys_when_enabled(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 1, "amount": 100000, "currency": "DKK"}`)
+	type testData struct {
+		Amount   int    `json:"amount" validation:"required|integer"`
+		Currency string `json:"currency" validation:"required|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithDuplicateKeyDetection()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "duplicateKey"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_duplicate_keys_at_any_depth(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"items": [{"amount": 1}, {"amount": 2, "amount": 3, "amount": 4}], "metadata": {"a": "1", "b": "2", "a": "3"}}`)
+	type testDataItem struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	type testData struct {
+		Items    []testDataItem    `json:"items" validation:"required|array"`
+		Metadata map[string]string `json:"metadata" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithDuplicateKeyDetection())
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("items.1.amount", "duplicateKey"))
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.a", "duplicateKey"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_does_not_treat_equal_keys_in_different_objects_as_duplicates(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 1, "child": {"amount": 2, "child": {"amount": 3}}}`)
+	type testDataChild struct {
+		Amount int            `json:"amount" validation:"required|integer"`
+		Child  *testDataChild `json:"child" validation:"nullable|object"`
+	}
+
+	// Act
+	var data testDataChild
+	err := JsonValidator.New(JsonValidator.WithDuplicateKeyDetection()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+}
+
+func Test_it_rejects_duplicate_keys_of_streamed_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := `[{"amount": 1}, {"amount": 2, "amount": 3}]`
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data []testData
+	err := JsonValidator.New(JsonValidator.WithDuplicateKeyDetection()).ValidateReader(context.Background(), strings.NewReader(jsonString), &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("1.amount", "duplicateKey"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_keys_setting_the_same_struct_field_when_enabled(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 1, "AMOUNT": 100000, "items": [{"id": 1, "Id": 2}], "metadata": {"a": "1", "A": "2"}}`)
+	type testDataItem struct {
+		Id int `json:"id" validation:"required|integer"`
+	}
+
+	type testData struct {
+		Amount   int               `json:"amount" validation:"required|integer|min:1|max:10"`
+		Items    []testDataItem    `json:"items" validation:"required|array"`
+		Metadata map[string]string `json:"metadata" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithDuplicateKeyDetection()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("AMOUNT", "duplicateKey"))
+	require.True(t, errorBag.HasFailedKeyAndRule("items.0.Id", "duplicateKey"))
+	require.Equal(t, 2, errorBag.CountErrors())
+	require.Equal(t, 0, data.Amount)
+}
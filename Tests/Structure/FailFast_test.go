@@ -0,0 +1,132 @@
+package Structure
+
+import (
+	"context"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func Test_it_reports_every_error_by_default(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"items": ["a", "b", "c", "d", "e"]}`)
+	type testData struct {
+		Items []any `json:"items" validation:"required|array|dive|integer"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, 5, errorBag.CountErrors())
+}
+
+func Test_it_stops_validation_after_the_maximum_number_of_errors(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"items": ["a", "b", "c", "d", "e"], "name": 1}`)
+	type testData struct {
+		Items []any `json:"items" validation:"required|array|dive|integer|min:10"`
+		Name  any   `json:"name" validation:"required|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithMaxErrors(3)).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("items.0", "integer"))
+	require.True(t, errorBag.HasFailedKeyAndRule("items.0", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("items.1", "integer"))
+	require.Equal(t, 3, errorBag.CountErrors())
+}
+
+func Test_it_can_limit_the_number_of_errors_for_a_single_validation(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"first": 1, "second": 2, "third": 3}`)
+	type testData struct {
+		First  any `json:"first" validation:"required|string"`
+		Second any `json:"second" validation:"required|string"`
+		Third  any `json:"third" validation:"required|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithMaxErrors(1))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("first", "string"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_stops_reading_streamed_entries_after_the_maximum_number_of_errors(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	// The json is invalid after the second entry, which is never read, since the maximum is already reached
+	jsonString := `[{"amount": "a"}, {"amount": "b"}, {"amount": `
+	type testData struct {
+		Amount any `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data []testData
+	err := JsonValidator.New(JsonValidator.WithMaxErrors(2)).ValidateReader(context.Background(), strings.NewReader(jsonString), &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("0.amount", "integer"))
+	require.True(t, errorBag.HasFailedKeyAndRule("1.amount", "integer"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_stops_running_rules_of_a_bailing_field_after_the_first_failure(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"bailing": 12345, "other": 12345}`)
+	type testData struct {
+		Bailing any `json:"bailing" validation:"required|bail|string|lenMax:3|regex:^[a-z]+$"`
+		Other   any `json:"other" validation:"required|string|lenMax:3|regex:^[a-z]+$"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("bailing", "string"))
+	require.Len(t, errorBag.GetErrorsForKey("bailing"), 1)
+	require.Len(t, errorBag.GetErrorsForKey("other"), 3)
+}
+
+func Test_it_runs_every_rule_of_a_bailing_field_without_failures(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"bailing": "abcd"}`)
+	type testData struct {
+		Bailing string `json:"bailing" validation:"required|bail|string|lenMax:3|regex:^[0-9]+$"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("bailing", "lenMax"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
@@ -0,0 +1,307 @@
+package Structure
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type implicitTestData struct {
+	Int8     int8              `json:"int8"`
+	Uint16   uint16            `json:"uint16"`
+	Float    float64           `json:"float"`
+	String   string            `json:"string"`
+	Bool     bool              `json:"bool"`
+	Slice    []string          `json:"slice"`
+	Bytes    []byte            `json:"bytes"`
+	Map      map[string]int    `json:"map"`
+	Child    implicitTestChild `json:"child"`
+	Time     time.Time         `json:"time"`
+	Pointer  *int              `json:"pointer"`
+	Any      any               `json:"any"`
+	Quoted   int               `json:"quoted,string"`
+	Ignored  int               `json:"-"`
+	internal int
+}
+
+type implicitTestChild struct {
+	Amount int `json:"amount"`
+}
+
+func Test_it_does_not_derive_type_rules_by_default(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"int8": "abc"}`)
+
+	// Act
+	var data implicitTestData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag)
+	require.True(t, errorBag.HasFailedKeyAndRule("int8", "type"))
+	require.False(t, errorBag.HasFailedKeyAndRule("int8", "int"))
+}
+
+func Test_it_accepts_valid_values_with_implicit_type_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{
+		"int8": -128,
+		"uint16": 65535,
+		"float": 1.5,
+		"string": "abc",
+		"bool": true,
+		"slice": ["a", "b"],
+		"bytes": "aGVsbG8=",
+		"map": {"a": 1},
+		"child": {"amount": 10},
+		"time": "2024-01-01T12:00:00Z",
+		"pointer": null,
+		"any": [1, "a"],
+		"quoted": "10"
+	}`)
+
+	// Act
+	var data implicitTestData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, int8(-128), data.Int8)
+	require.Equal(t, "hello", string(data.Bytes))
+	require.Equal(t, 10, data.Quoted)
+}
+
+func Test_it_reports_type_mismatches_with_implicit_type_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{
+		"int8": 128,
+		"uint16": -1,
+		"float": "1.5",
+		"string": 1,
+		"bool": "true",
+		"slice": "a",
+		"bytes": [1, 2],
+		"map": [],
+		"child": {"amount": "abc"},
+		"time": "2024-01-01",
+		"pointer": "abc",
+		"quoted": 10,
+		"Ignored": "abc",
+		"internal": "abc"
+	}`)
+
+	// Act
+	var data implicitTestData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("int8", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("uint16", "uint"))
+	require.True(t, errorBag.HasFailedKeyAndRule("float", "float"))
+	require.True(t, errorBag.HasFailedKeyAndRule("string", "string"))
+	require.True(t, errorBag.HasFailedKeyAndRule("bool", "bool"))
+	require.True(t, errorBag.HasFailedKeyAndRule("slice", "array"))
+	require.True(t, errorBag.HasFailedKeyAndRule("bytes", "string"))
+	require.True(t, errorBag.HasFailedKeyAndRule("map", "object"))
+	require.True(t, errorBag.HasFailedKeyAndRule("child.amount", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("time", "rfc3339"))
+	require.True(t, errorBag.HasFailedKeyAndRule("pointer", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("quoted", "string"))
+	require.Equal(t, 12, errorBag.CountErrors())
+}
+
+func Test_it_applies_implicit_type_rules_to_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"slice": [1, "a", null], "pointers": [1, null, "a"], "map": {"a": 1, "b": true}}`)
+	type testData struct {
+		Slice    []int          `json:"slice"`
+		Pointers []*int         `json:"pointers"`
+		Map      map[string]int `json:"map"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("slice.1", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("slice.2", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("pointers.2", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("map.b", "int"))
+	require.Equal(t, 4, errorBag.CountErrors())
+}
+
+func Test_it_merges_implicit_type_rules_with_explicit_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": "abc", "count": 300, "limited": 300}`)
+	type testData struct {
+		Amount  int   `json:"amount" validation:"required|min:1"`
+		Count   uint8 `json:"count" validation:"required|integer"`
+		Limited int   `json:"limited" validation:"required|max:100"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("count", "uint"), "explicit integer rules do not check the range of the type")
+	require.True(t, errorBag.HasFailedKeyAndRule("limited", "max"))
+	require.Equal(t, 4, errorBag.CountErrors())
+}
+
+func Test_it_keeps_the_range_of_integer_types_with_explicit_integer_rules(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString    string
+		expectedRules []string
+	}{
+		{`{"integer": 300}`, []string{"int"}},
+		{`{"integer": 1.5}`, []string{"integer"}},
+		{`{"integer": "abc"}`, []string{"integer"}},
+		{`{"integer": 100, "sized": 100, "narrowed": 5, "widened": 100}`, []string{}},
+		{`{"sized": 300}`, []string{"int"}},
+		{`{"narrowed": 100, "widened": 300}`, []string{"int", "int"}},
+	}
+
+	type testData struct {
+		Integer  int8 `json:"integer" validation:"integer"`
+		Sized    int8 `json:"sized" validation:"int:8"`
+		Narrowed int8 `json:"narrowed" validation:"int:4"`
+		Widened  int8 `json:"widened" validation:"int:16"`
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data testData
+			err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			rules := []string{}
+
+			for _, fieldError := range errorBag.GetFieldErrors() {
+				rules = append(rules, fieldError.Rule)
+			}
+
+			require.Equal(t, testCase.expectedRules, rules, err)
+		})
+	}
+}
+
+func Test_it_applies_implicit_type_rules_to_top_level_documents(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`[1, "a"]`)
+
+	// Act
+	var data []int
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("1", "int"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_null_for_fields_which_are_not_pointers_with_implicit_type_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": null, "tags": null, "note": null}`)
+	type testData struct {
+		Amount int      `json:"amount"`
+		Tags   []string `json:"tags"`
+		Note   *string  `json:"note"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "int"))
+	require.True(t, errorBag.HasFailedKeyAndRule("tags", "array"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+// ImplicitTestReference and ImplicitTestAddress are exported, since json only sets embedded fields of exported types.
+type ImplicitTestReference string
+
+type ImplicitTestAddress struct {
+	City string `json:"city"`
+}
+
+// implicitTestWindow is unmarshalled by the promoted UnmarshalJSON of the embedded time.Time.
+type implicitTestWindow struct {
+	time.Time
+}
+
+func Test_it_applies_implicit_type_rules_to_embedded_fields_with_a_json_key(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"ImplicitTestReference": 1, "address": "a", "amount": "b"}`)
+	type testData struct {
+		ImplicitTestReference
+		ImplicitTestAddress `json:"address"`
+		implicitTestChild
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("ImplicitTestReference", "string"), errorBag.Error())
+	require.True(t, errorBag.HasFailedKeyAndRule("address", "object"), errorBag.Error())
+	require.True(t, errorBag.HasFailedKeyAndRule("amount", "int"), errorBag.Error())
+	require.Equal(t, 3, errorBag.CountErrors())
+}
+
+func Test_it_leaves_the_json_values_of_types_embedding_an_unmarshaler_to_the_unmarshaler(t *testing.T) {
+	// Arrange
+	type testData struct {
+		Window implicitTestWindow `json:"window"`
+	}
+
+	validator := JsonValidator.New(JsonValidator.WithImplicitTypeRules())
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data testData
+	err := validator.Validate([]byte(`{"window": "2024-01-02T03:04:05Z"}`), &data)
+	var invalidData testData
+	invalidErr := validator.Validate([]byte(`{"window": {}}`), &invalidData)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC), data.Window.Time)
+	require.ErrorIs(t, invalidErr, JsonValidator.ErrValidationFailed)
+	require.False(t, errors.As(invalidErr, &errorBag))
+}
@@ -0,0 +1,165 @@
+package Structure
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_it_can_validate_valid_map_keys(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"orderId": "a", "customerId": "b"}}`)
+	type testData struct {
+		Metadata map[string]string `json:"metadata" validation:"required|object|dive|keys|regex:^[a-zA-Z]+$|lenMax:16|endkeys|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_invalid_map_keys(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"orderId": "a", "customer-id": "b", "aVeryLongMetadataKey": 5}}`)
+	type testData struct {
+		Metadata map[string]string `json:"metadata" validation:"required|object|dive|keys|regex:^[a-zA-Z]+$|lenMax:16|endkeys|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.customer-id", "regex"))
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.aVeryLongMetadataKey", "lenMax"))
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.aVeryLongMetadataKey", "string"))
+	require.Equal(t, 3, errorBag.CountErrors())
+}
+
+func Test_it_tells_errors_of_map_keys_from_errors_of_map_values(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"customer-id": 5}}`)
+	type testData struct {
+		Metadata map[string]string `json:"metadata" validation:"required|object|dive|keys|regex:^[a-zA-Z]+$|endkeys|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+	fieldErrors := errorBag.GetFieldErrorsForKey("metadata.customer-id")
+	problemJson, _ := json.Marshal(JsonValidator.NewProblem(err))
+	problem, _ := JsonValidator.ParseProblem(problemJson)
+
+	// Assert
+	require.Len(t, fieldErrors, 2)
+	require.Equal(t, "regex", fieldErrors[0].Rule)
+	require.True(t, fieldErrors[0].IsKey)
+	require.Equal(t, "customer-id", fieldErrors[0].Value)
+	require.Equal(t, "string", fieldErrors[1].Rule)
+	require.False(t, fieldErrors[1].IsKey)
+	require.True(t, problem.ErrorBag().GetFieldErrors()[0].IsKey)
+	require.False(t, problem.ErrorBag().GetFieldErrors()[1].IsKey)
+}
+
+func Test_it_can_validate_map_keys_without_entry_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"fd72503c-84d9-46f1-896f-4e9d774229dc": 1, "abc": 2}}`)
+	type testData struct {
+		Metadata map[string]int `json:"metadata" validation:"object|dive|keys|uuid|endkeys"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("metadata.abc", "uuid"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_validates_integer_map_keys_are_convertible(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"counts": {"1": 1, "-2": 2, "abc": 3, "300": 4}}`)
+	type testData struct {
+		Counts map[int8]int `json:"counts" validation:"object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("counts.abc", "mapKey"))
+	require.True(t, errorBag.HasFailedKeyAndRule("counts.300", "mapKey"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_validates_unsigned_integer_map_keys_before_other_key_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"counts": {"1": 1, "-2": 2}}`)
+	type testData struct {
+		Counts map[uint]int `json:"counts" validation:"object|dive|keys|in:1,2|endkeys"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("counts.-2", "mapKey"))
+	require.True(t, errorBag.HasFailedKeyAndRule("counts.-2", "in"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_can_unmarshal_valid_integer_map_keys(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"counts": {"1": 1, "-2": 2}}`)
+	type testData struct {
+		Counts map[int]int `json:"counts" validation:"object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, map[int]int{1: 1, -2: 2}, data.Counts)
+}
+
+func Test_it_rejects_key_rules_for_fields_without_keys(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"tags": ["abc"]}`)
+	type testData struct {
+		Tags []string `json:"tags" validation:"array|dive|keys|uuid|endkeys|string"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrInvalidSchema)
+}
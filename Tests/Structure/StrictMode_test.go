@@ -0,0 +1,277 @@
+package Structure
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func Test_it_ignores_unknown_keys_by_default(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 100, "ammount": 100}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+}
+
+func Test_it_rejects_unknown_keys_in_strict_mode(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 100, "ammount": 100, "Amount": 100}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithStrictMode()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("ammount", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("Amount", "strict"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_can_enable_strict_mode_for_a_single_validation(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 100, "ammount": 100}`)
+	type testData struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+	validator := JsonValidator.New()
+
+	// Act
+	var data testData
+	err := validator.Validate(jsonString, &data, JsonValidator.WithStrictMode())
+	_ = errors.As(err, &errorBag)
+	errWithoutStrictMode := validator.Validate(jsonString, &data)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("ammount", "strict"))
+	require.Equal(t, 1, errorBag.CountErrors())
+	require.NoError(t, errWithoutStrictMode)
+}
+
+func Test_it_rejects_unknown_keys_in_nested_structs_in_strict_mode(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"items": [{"amount": 1}, {"amount": 2, "currency": "DKK"}], "user": {"nme": "John"}}`)
+	type testDataItem struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	type testDataUser struct {
+		Name string `json:"name" validation:"string"`
+	}
+
+	type testData struct {
+		Items []testDataItem `json:"items" validation:"required|array"`
+		User  testDataUser   `json:"user" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithStrictMode()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("items.1.currency", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("user.nme", "strict"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_accepts_keys_of_embedded_structs_in_strict_mode(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"id": "abc", "amount": 100, "other": true}`)
+	type testDataBase struct {
+		Id string `json:"id" validation:"required|string"`
+	}
+
+	type testData struct {
+		testDataBase
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithStrictMode()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("other", "strict"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_keys_of_fields_json_ignores_in_strict_mode(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"amount": 100, "-": "dash", "Internal": "x", "secret": "y", "internal": "z"}`)
+	type testData struct {
+		Amount   int    `json:"amount" validation:"required|integer"`
+		Internal string `json:"-"`
+		secret   string
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithStrictMode()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("-", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("Internal", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("secret", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("internal", "strict"))
+	require.Equal(t, 4, errorBag.CountErrors())
+	require.Empty(t, data.secret)
+}
+
+func Test_it_does_not_reject_unknown_keys_of_maps_in_strict_mode(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"metadata": {"anything": "goes"}}`)
+	type testData struct {
+		Metadata map[string]string `json:"metadata" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New(JsonValidator.WithStrictMode()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 0, errorBag.CountErrors())
+}
+
+func Test_it_can_enable_strict_mode_for_a_single_field(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"strict": {"name": "a", "nme": "b"}, "lenient": {"name": "a", "nme": "b"}, "unknown": 1}`)
+	type testDataChild struct {
+		Name string `json:"name" validation:"string"`
+	}
+
+	type testData struct {
+		Strict  testDataChild `json:"strict" validation:"required|strict|object"`
+		Lenient testDataChild `json:"lenient" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("strict.nme", "strict"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_enable_strict_mode_for_slice_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"items": [{"amount": 1}, {"amount": 2, "ammount": 2}]}`)
+	type testDataItem struct {
+		Amount int `json:"amount" validation:"required|integer"`
+	}
+
+	type testData struct {
+		Items []testDataItem `json:"items" validation:"required|array|dive|strict|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("items.1.ammount", "strict"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_rejects_strict_rule_for_fields_which_are_not_structs(t *testing.T) {
+	// Setup
+	type strictMap struct {
+		Metadata map[string]int `json:"metadata" validation:"strict|object"`
+	}
+
+	type strictSlice struct {
+		Items []strictTestDataChild `json:"items" validation:"strict|array"`
+	}
+
+	cases := []any{&strictMap{}, &strictSlice{}}
+
+	for i, target := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Act
+			err := JsonValidator.New().Validate([]byte(`{}`), target)
+
+			// Assert
+			require.ErrorIs(t, err, JsonValidator.ErrInvalidSchema)
+		})
+	}
+}
+
+type strictTestDataChild struct {
+	JsonValidator.Strict
+	Name string `json:"name" validation:"string"`
+}
+
+func Test_it_can_enable_strict_mode_for_a_struct_type(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"first": {"name": "a", "nme": "b"}, "second": {"name": "a", "Strict": {}}, "unknown": 1}`)
+	type testData struct {
+		First  strictTestDataChild  `json:"first" validation:"required|object"`
+		Second *strictTestDataChild `json:"second" validation:"required|object"`
+	}
+
+	// Act
+	var data testData
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("first.nme", "strict"))
+	require.True(t, errorBag.HasFailedKeyAndRule("second.Strict", "strict"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_rejects_unknown_keys_of_a_strict_root_struct(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"name": "a", "nme": "b"}`)
+
+	// Act
+	var data strictTestDataChild
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("nme", "strict"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
@@ -0,0 +1,154 @@
+package Structure
+
+import (
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type topLevelPayment struct {
+	Amount   int    `json:"amount" validation:"required|integer|min:1"`
+	Currency string `json:"currency" validation:"required|alpha3Currency"`
+}
+
+func Test_it_can_validate_top_level_arrays(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`[{"amount": 1, "currency": "DKK"}, {"amount": 0, "currency": "DKK"}, {"currency": "WTF"}]`)
+
+	// Act
+	var data []topLevelPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("1.amount", "min"))
+	require.True(t, errorBag.HasFailedKeyAndRule("2.amount", "required"))
+	require.True(t, errorBag.HasFailedKeyAndRule("2.currency", "alpha3Currency"))
+	require.Equal(t, 3, errorBag.CountErrors())
+}
+
+func Test_it_can_unmarshal_valid_top_level_arrays(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`[{"amount": 1, "currency": "DKK"}, {"amount": 2, "currency": "SEK"}]`)
+
+	// Act
+	var data []topLevelPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []topLevelPayment{{1, "DKK"}, {2, "SEK"}}, data)
+}
+
+func Test_it_can_validate_top_level_arrays_using_root_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`[{"amount": 1, "currency": "DKK"}, null, {"amount": 1, "currency": "DKK"}]`)
+
+	// Act
+	var data []*topLevelPayment
+	err := JsonValidator.New().Validate(jsonString, &data, JsonValidator.WithRootRules("required|array|lenMax:3|dive|required|object"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("1", "required"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_reports_root_rule_errors_on_the_empty_path(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`[{"amount": 1, "currency": "DKK"}, {"amount": 1, "currency": "DKK"}]`)
+
+	// Act
+	var data []topLevelPayment
+	err := JsonValidator.New(JsonValidator.WithRootRules("array|lenMax:1")).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("", "lenMax"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_top_level_maps(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"a": {"amount": 1, "currency": "DKK"}, "b": {"amount": -1, "currency": "DKK"}}`)
+
+	// Act
+	var data map[string]topLevelPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("b.amount", "min"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_top_level_scalars(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString []byte
+		failedRule string
+	}{
+		{[]byte(`5`), ""},
+		{[]byte(`0`), "min"},
+		{[]byte(`"5"`), "integer"},
+		{[]byte(`null`), "required"},
+	}
+
+	for _, testCase := range cases {
+		// Arrange
+		var errorBag *JsonValidator.ErrorBag
+
+		// Act
+		var data int
+		err := JsonValidator.New().Validate(testCase.jsonString, &data, JsonValidator.WithRootRules("required|integer|min:1"))
+		_ = errors.As(err, &errorBag)
+
+		// Assert
+		if testCase.failedRule != "" {
+			require.Error(t, err)
+			require.True(t, errorBag.HasFailedKeyAndRule("", testCase.failedRule))
+		} else {
+			require.NoError(t, err)
+			require.Equal(t, 5, data)
+		}
+	}
+}
+
+func Test_it_rejects_non_object_documents_for_struct_targets(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`[{"amount": 1, "currency": "DKK"}]`)
+
+	// Act
+	var data topLevelPayment
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("", "object"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_top_level_documents_using_typed_validators(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	typed, _ := JsonValidator.ValidatorFor[[]topLevelPayment]()
+
+	// Act
+	_, err := typed.Validate([]byte(`[{"amount": 1, "currency": "DKK"}, {"amount": 1, "currency": "DKK"}]`), JsonValidator.WithRootRules("lenMax:1"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("", "lenMax"))
+}
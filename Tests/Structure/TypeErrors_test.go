@@ -0,0 +1,118 @@
+package Structure
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/netip"
+	"strings"
+	"testing"
+	"time"
+)
+
+type typeErrorTestLine struct {
+	Amount   int64  `json:"amount" validation:"present"`
+	Quantity uint8  `json:"quantity"`
+	Note     string `json:"note"`
+}
+
+type typeErrorTestOrder struct {
+	Reference string              `json:"reference" validation:"required|string"`
+	Lines     []typeErrorTestLine `json:"lines"`
+	Totals    map[int]float64     `json:"totals"`
+	Address   netip.Addr          `json:"address"`
+	Quoted    int                 `json:"quoted,string"`
+	Created   time.Time           `json:"created"`
+	Bytes     []byte              `json:"bytes"`
+}
+
+func Test_it_reports_unmarshal_errors_as_type_errors(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString   string
+		expectedPath string
+		typeName     string
+	}{
+		{`{"reference": "a", "lines": [{"amount": 1}, {"amount": "abc"}]}`, "lines.1.amount", "int64"},
+		{`{"reference": "a", "lines": [{"amount": 1, "quantity": 300}]}`, "lines.0.quantity", "uint8"},
+		{`{"reference": "a", "lines": [{"amount": 1, "note": {"a": 1}}]}`, "lines.0.note", "string"},
+		{`{"reference": "a", "lines": {"amount": 1}}`, "lines", "[]Structure.typeErrorTestLine"},
+		{`{"reference": "a", "totals": {"1": true}}`, "totals.1", "float64"},
+		{`{"reference": "a", "quoted": 12}`, "quoted", "int"},
+		{`{"reference": "a", "address": {"ip": "127.0.0.1"}}`, "address", "netip.Addr"},
+		{`{"reference": "a", "address": ["127.0.0.1"]}`, "address", "netip.Addr"},
+		{`{"reference": "a", "bytes": "not base64!"}`, "bytes", "[]uint8"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data typeErrorTestOrder
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.NotNil(t, errorBag, err)
+			require.Equal(t, 1, errorBag.CountErrors(), errorBag.Error())
+			require.True(t, errorBag.HasFailedKeyAndRule(testCase.expectedPath, "type"), errorBag.Error())
+			require.True(t, strings.Contains(errorBag.GetErrorsForKey(testCase.expectedPath)[0], testCase.typeName), errorBag.Error())
+		})
+	}
+}
+
+func Test_it_returns_errors_of_custom_unmarshalers_as_failed_validation(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	var parseError *time.ParseError
+	jsonString := []byte(`{"reference": "a", "created": "yesterday"}`)
+
+	// Act
+	var data typeErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+
+	// Assert
+	require.False(t, errors.As(err, &errorBag))
+	require.True(t, errors.As(err, &parseError))
+	require.ErrorIs(t, err, JsonValidator.ErrValidationFailed)
+	require.Equal(t, http.StatusUnprocessableEntity, JsonValidator.NewProblem(err).Status)
+}
+
+func Test_validation_errors_take_priority_over_type_errors(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"lines": [{"amount": "abc"}]}`)
+
+	// Act
+	var data typeErrorTestOrder
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag)
+	require.True(t, errorBag.HasFailedKeyAndRule("reference", "required"))
+	require.False(t, errorBag.HasFailedKeyAndRule("lines.0.amount", "type"))
+}
+
+func Test_it_reports_type_errors_of_streamed_entries(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := `[{"amount": 1}, {"amount": "abc"}, {}]`
+
+	// Act
+	var data []typeErrorTestLine
+	err := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(jsonString), &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag, err)
+	require.Equal(t, 2, errorBag.CountErrors())
+	require.True(t, errorBag.HasFailedKeyAndRule("1.amount", "type"))
+	require.True(t, errorBag.HasFailedKeyAndRule("2.amount", "present"))
+	require.Nil(t, data)
+}
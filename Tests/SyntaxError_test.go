@@ -0,0 +1,103 @@
+package Tests
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"io"
+	"strings"
+	"testing"
+)
+
+type syntaxTestPayment struct {
+	Amount   int    `json:"amount"`
+	Currency string `json:"currency"`
+}
+
+func Test_it_describes_where_the_json_is_invalid(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString  string
+		offset      int64
+		line        int
+		column      int
+		excerpt     string
+		expectedErr error
+	}{
+		{`{"amount": x}`, 12, 1, 12, `"amount": x`, nil},
+		{"{\n  \"amount\": 10,\n  \"currency\": DKK\n}", 33, 3, 15, "\"currency\": DKK", nil},
+		{`{"amount": 10`, 13, 1, 13, `{"amount": 10`, io.ErrUnexpectedEOF},
+		{``, 0, 1, 1, ``, JsonValidator.ErrEmptyJson},
+		{"  \n ", 4, 2, 1, "  \n ", JsonValidator.ErrEmptyJson},
+		{`{"amount": 10} {"amount": 20}`, 16, 1, 16, `10} {"amount"`, JsonValidator.ErrTrailingData},
+		{"{\"amount\": 10}\n\n  garbage", 19, 3, 3, "10}\n\n  garbage", JsonValidator.ErrTrailingData},
+		{`{"currency": "` + strings.Repeat("A", 50) + `", "amount": 1,}`, 80, 1, 80, `AAAA", "amount": 1,}`, nil},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var syntaxError *JsonValidator.SyntaxError
+
+			// Act
+			var data syntaxTestPayment
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data)
+
+			// Assert
+			require.True(t, errors.As(err, &syntaxError), err)
+			require.Equal(t, testCase.offset, syntaxError.Offset)
+			require.Equal(t, testCase.line, syntaxError.Line)
+			require.Equal(t, testCase.column, syntaxError.Column)
+			require.Contains(t, syntaxError.Excerpt, testCase.excerpt)
+			require.LessOrEqual(t, len(syntaxError.Excerpt), 40)
+
+			if testCase.expectedErr != nil {
+				require.ErrorIs(t, err, testCase.expectedErr)
+			} else {
+				var jsonSyntaxError *json.SyntaxError
+				require.True(t, errors.As(err, &jsonSyntaxError))
+			}
+		})
+	}
+}
+
+func Test_it_describes_invalid_json_from_a_reader_like_from_bytes(t *testing.T) {
+	// Setup
+	entries := strings.Repeat(`{"amount": 1, "currency": "DKK"},`+"\n", 1000)
+	cases := []string{
+		`{"amount": x}`,
+		"{\n  \"amount\": 10,\n  \"currency\": DKK\n}",
+		`{"amount": 10} garbage`,
+		`[` + entries + `{"amount": 1, "currency": DKK}]`,
+		`[` + entries + `{"amount": 1, "currency": "DKK"}] garbage`,
+		`[` + entries + `{"amount": 1, "currency": "DKK"}`,
+		`[` + entries + `]`,
+		"\n\n  {\"amount\": x}",
+		"\n  {\"amount\": 10}\n garbage",
+		"\n  [" + entries + `{"amount": 1, "currency": DKK}]`,
+		"\n  [" + entries + `{"amount": 1, "currency": "DKK"}`,
+	}
+
+	for i, jsonString := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var expected, actual *JsonValidator.SyntaxError
+
+			// Act
+			var bytesData, readerData []syntaxTestPayment
+			bytesErr := JsonValidator.New().Validate([]byte(jsonString), &bytesData)
+			readerErr := JsonValidator.New().ValidateReader(context.Background(), strings.NewReader(jsonString), &readerData)
+
+			// Assert
+			require.True(t, errors.As(bytesErr, &expected), bytesErr)
+			require.True(t, errors.As(readerErr, &actual), readerErr)
+			require.Equal(t, expected.Offset, actual.Offset)
+			require.Equal(t, expected.Line, actual.Line)
+			require.Equal(t, expected.Column, actual.Column)
+			require.Equal(t, expected.Excerpt, actual.Excerpt)
+		})
+	}
+}
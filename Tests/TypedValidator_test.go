@@ -0,0 +1,137 @@
+package Tests
+
+import (
+	"errors"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"sync"
+	"testing"
+)
+
+type typedTestRequest struct {
+	Id   string `json:"id" validation:"required|string|uuid"`
+	Name string `json:"name" validation:"present|string|lenMax:5"`
+}
+
+func Test_it_can_validate_using_the_generic_validate_function(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"id": "fd72503c-84d9-46f1-896f-4e9d774229dc", "name": "abc"}`)
+
+	// Act
+	request, err := JsonValidator.Validate[typedTestRequest](jsonString)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "fd72503c-84d9-46f1-896f-4e9d774229dc", request.Id)
+	require.Equal(t, "abc", request.Name)
+}
+
+func Test_it_returns_validation_errors_from_the_generic_validate_function(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	jsonString := []byte(`{"id": "abc"}`)
+
+	// Act
+	_, err := JsonValidator.Validate[typedTestRequest](jsonString)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Error(t, err)
+	require.True(t, errorBag.HasFailedKeyAndRule("id", "uuid"))
+	require.True(t, errorBag.HasFailedKeyAndRule("name", "present"))
+	require.Equal(t, 2, errorBag.CountErrors())
+}
+
+func Test_it_can_validate_into_an_existing_value(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"id": "fd72503c-84d9-46f1-896f-4e9d774229dc", "name": "abc"}`)
+
+	// Act
+	var request typedTestRequest
+	err := JsonValidator.ValidateInto(jsonString, &request)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "abc", request.Name)
+}
+
+func Test_it_can_validate_using_a_typed_validator(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	typed, analyzeErr := JsonValidator.ValidatorFor[typedTestRequest]()
+
+	// Act
+	valid, validErr := typed.Validate([]byte(`{"id": "fd72503c-84d9-46f1-896f-4e9d774229dc", "name": "abc"}`))
+	_, invalidErr := typed.Validate([]byte(`{"id": "fd72503c-84d9-46f1-896f-4e9d774229dc", "name": "abcdef"}`))
+	_ = errors.As(invalidErr, &errorBag)
+
+	// Assert
+	require.NoError(t, analyzeErr)
+	require.NoError(t, validErr)
+	require.Equal(t, "abc", valid.Name)
+	require.True(t, errorBag.HasFailedKeyAndRule("name", "lenMax"))
+	require.Equal(t, 1, errorBag.CountErrors())
+}
+
+func Test_it_can_create_typed_validators_using_custom_rules(t *testing.T) {
+	// Arrange
+	var errorBag *JsonValidator.ErrorBag
+	validator := JsonValidator.New()
+	validator.RegisterRule(JsonValidator.Rule{
+		Name: "MyRule",
+		Function: func(context *JsonValidator.FieldValidationContext) (string, bool) {
+			return "My RuleContext Ran", false
+		},
+	})
+
+	type testData struct {
+		Data string `validation:"MyRule"`
+	}
+
+	typed, analyzeErr := JsonValidator.NewTypedValidator[testData](validator)
+
+	// Act
+	_, err := typed.Validate([]byte(`{"Data": "abc"}`))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NoError(t, analyzeErr)
+	require.True(t, errorBag.HasFailedKeyAndRule("Data", "MyRule"))
+}
+
+func Test_it_returns_analysis_errors_when_creating_typed_validators(t *testing.T) {
+	// Act
+	typed, err := JsonValidator.ValidatorFor[func()]()
+
+	// Assert
+	require.Error(t, err)
+	require.Nil(t, typed)
+}
+
+func Test_it_can_validate_concurrently_using_the_default_validator(t *testing.T) {
+	// Arrange
+	type concurrentData struct {
+		Id   int    `json:"id" validation:"required|integer"`
+		Text string `json:"text" validation:"required|string"`
+	}
+
+	waitGroup := sync.WaitGroup{}
+	results := make([]error, 50)
+
+	// Act
+	for i := range results {
+		waitGroup.Add(1)
+
+		go func(i int) {
+			defer waitGroup.Done()
+			_, results[i] = JsonValidator.Validate[concurrentData]([]byte(`{"id": 1, "text": "abc"}`))
+		}(i)
+	}
+
+	waitGroup.Wait()
+
+	// Assert
+	for _, err := range results {
+		require.NoError(t, err)
+	}
+}
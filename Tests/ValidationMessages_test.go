@@ -0,0 +1,158 @@
+package Tests
+
+import (
+	"errors"
+	"fmt"
+	"github.com/epay-technology/json-validator-go/JsonValidator"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+type messageOverrideOrder struct {
+	Reference string   `json:"reference" validation:"required|string|lenBetween:1,64" validationMessage:"required=Order reference is required|lenBetween=Order reference must be {0}–{1} characters"`
+	Currency  string   `json:"currency" validation:"required|in:DKK,EUR" validationMessage:"in=The {field} [{value}] is not one of {params}"`
+	Tags      []string `json:"tags" validation:"array|dive|lenMax:3" validationMessage:"lenMax=Tag {field} must be at most {0} characters"`
+	Note      string   `json:"note" validation:"lenMax:5"`
+}
+
+func Test_it_overrides_messages_with_the_validation_message_tag(t *testing.T) {
+	// Setup
+	cases := []struct {
+		jsonString      string
+		path            string
+		expectedMessage string
+	}{
+		{`{"currency": "DKK"}`, "reference", "Order reference is required"},
+		{`{"reference": "", "currency": "DKK"}`, "reference", "Order reference must be 1–64 characters"},
+		{`{"reference": "a", "currency": "SEK"}`, "currency", "The currency [SEK] is not one of DKK, EUR"},
+		{`{"reference": "a", "currency": "DKK", "tags": ["abc", "abcd"]}`, "tags.1", "Tag 1 must be at most 3 characters"},
+	}
+
+	for i, testCase := range cases {
+		t.Run(fmt.Sprintf("%d", i), func(t *testing.T) {
+			// Arrange
+			var errorBag *JsonValidator.ErrorBag
+
+			// Act
+			var data messageOverrideOrder
+			err := JsonValidator.New().Validate([]byte(testCase.jsonString), &data)
+			_ = errors.As(err, &errorBag)
+
+			// Assert
+			require.Len(t, errorBag.GetFieldErrorsForKey(testCase.path), 1)
+			require.Equal(t, testCase.expectedMessage, errorBag.GetFieldErrorsForKey(testCase.path)[0].Message)
+		})
+	}
+}
+
+func Test_it_overrides_messages_of_every_field_with_the_messages_option(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": "a", "currency": "DKK", "note": "too long"}`)
+	validator := JsonValidator.New(JsonValidator.WithMessages(JsonValidator.MessageCatalog{
+		"lenMax": "{field} must be at most {0} characters",
+	}))
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageOverrideOrder
+	err := validator.Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[lenMax]: note must be at most 5 characters"}, errorBag.GetErrorsForKey("note"))
+}
+
+func Test_it_prefers_the_validation_message_tag_over_the_messages_option_and_the_locale(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"reference": "", "currency": "SEK", "note": "too long"}`)
+	validator := JsonValidator.New(JsonValidator.WithMessages(JsonValidator.MessageCatalog{
+		"lenBetween": "Must have a length between {0} and {1}",
+		"lenMax":     "Must have a length of at most {0}",
+	}))
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageOverrideOrder
+	err := validator.Validate(jsonString, &data, JsonValidator.WithLocale("da"))
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[lenBetween]: Order reference must be 1–64 characters"}, errorBag.GetErrorsForKey("reference"))
+	require.Equal(t, []string{"[lenMax]: Must have a length of at most 5"}, errorBag.GetErrorsForKey("note"))
+	require.Equal(t, []string{"[in]: The currency [SEK] is not one of DKK, EUR"}, errorBag.GetErrorsForKey("currency"))
+}
+
+func Test_it_keeps_validation_messages_to_the_field_declaring_them(t *testing.T) {
+	// Arrange
+	type sharedTypes struct {
+		First  []string `json:"first" validation:"dive|lenMax:1" validationMessage:"lenMax=Too long"`
+		Second []string `json:"second" validation:"dive|lenMax:1"`
+	}
+
+	jsonString := []byte(`{"first": ["ab"], "second": ["ab"]}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data sharedTypes
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[lenMax]: Too long"}, errorBag.GetErrorsForKey("first.0"))
+	require.NotEqual(t, []string{"[lenMax]: Too long"}, errorBag.GetErrorsForKey("second.0"))
+}
+
+func Test_it_keeps_validation_messages_to_the_field_declaring_them_when_entries_share_their_type(t *testing.T) {
+	// Arrange
+	type sharedTypes struct {
+		First  []string `json:"first" validationMessage:"string=Must be text"`
+		Second []string `json:"second"`
+	}
+
+	jsonString := []byte(`{"first": [1], "second": [1]}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data sharedTypes
+	err := JsonValidator.New(JsonValidator.WithImplicitTypeRules()).Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.Equal(t, []string{"[string]: Must be text"}, errorBag.GetErrorsForKey("first.0"))
+	require.Equal(t, []string{"[string]: Must be a string"}, errorBag.GetErrorsForKey("second.0"))
+}
+
+type messageTestNode struct {
+	Name string            `json:"name" validation:"required|string" validationMessage:"required=A node needs a name"`
+	Kids []messageTestNode `json:"kids" validation:"array" validationMessage:"array=Kids must be a list"`
+}
+
+func Test_it_applies_validation_messages_of_recursive_types(t *testing.T) {
+	// Arrange
+	jsonString := []byte(`{"name": "a", "kids": [{"kids": 1}]}`)
+	var errorBag *JsonValidator.ErrorBag
+
+	// Act
+	var data messageTestNode
+	err := JsonValidator.New().Validate(jsonString, &data)
+	_ = errors.As(err, &errorBag)
+
+	// Assert
+	require.NotNil(t, errorBag, err)
+	require.Equal(t, []string{"[required]: A node needs a name"}, errorBag.GetErrorsForKey("kids.0.name"))
+	require.Equal(t, []string{"[array]: Kids must be a list"}, errorBag.GetErrorsForKey("kids.0.kids"))
+}
+
+func Test_it_rejects_invalid_validation_message_tags(t *testing.T) {
+	// Arrange
+	type invalidMessages struct {
+		Name string `json:"name" validation:"required" validationMessage:"Name is required"`
+	}
+
+	// Act
+	var data invalidMessages
+	err := JsonValidator.New().Validate([]byte(`{}`), &data)
+
+	// Assert
+	require.ErrorIs(t, err, JsonValidator.ErrInvalidSchema)
+}